@@ -14,7 +14,7 @@ import (
 
 func HandleAbout(w http.ResponseWriter, r *http.Request) {
 
-	pageURL := teseo.GetFullURL(r)
+	pageURL := (&teseo.URLResolver{}).Resolve(r)
 	bcl, err := schemaorg.NewBreadcrumbListFromUrl(pageURL)
 	if err != nil {
 		log.Fatalf("Error generating breadcrumb list: %v", err)
@@ -0,0 +1,166 @@
+package teseo
+
+import (
+	"context"
+	"html/template"
+	"io"
+	"net/http"
+	"reflect"
+	"sync"
+
+	"github.com/a-h/templ"
+)
+
+type metadataKey struct{}
+
+// Metadata collects the SEO values of a request, so handlers deep in the stack can set them
+// and the layout rendering the page head renders whatever was collected.
+// All methods are safe for concurrent use and are no-ops on a nil Metadata.
+//
+// Example usage:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("/products/{id}", productHandler)
+//	http.ListenAndServe(":8080", teseo.Middleware(site)(mux))
+//
+//	// In the handler
+//	md := teseo.FromContext(r.Context())
+//	md.SetOpenGraph(&opengraph.Product{...})
+//	md.AddJsonLd(&schemaorg.Product{...})
+//
+// // Rendering using templ, in the layout:
+//
+//	templ Layout() {
+//		<head>
+//			@teseo.ContextHead()
+//		</head>
+//	}
+//
+// // Rendering as `template.HTML` value:
+//
+//	headHtml, err := teseo.FromContext(r.Context()).ToGoHTML()
+type Metadata struct {
+	mu          sync.Mutex
+	site        *Site
+	openGraph   any
+	twitterCard any
+	items       []any
+}
+
+// NewMetadata initializes a Metadata merging the site defaults into the collected values when rendered.
+// site can be nil.
+func NewMetadata(site *Site) *Metadata {
+	return &Metadata{site: site}
+}
+
+// WithMetadata returns a copy of ctx carrying md.
+func WithMetadata(ctx context.Context, md *Metadata) context.Context {
+	return context.WithValue(ctx, metadataKey{}, md)
+}
+
+// FromContext returns the Metadata carried by ctx, nil when there is none.
+// Calling the methods of a nil Metadata is safe, so handlers work without the middleware too.
+func FromContext(ctx context.Context) *Metadata {
+	md, _ := ctx.Value(metadataKey{}).(*Metadata)
+	return md
+}
+
+// Middleware returns a `net/http` middleware attaching a new Metadata to the context of every request.
+// The site defaults are merged into the collected values when rendered; site can be nil.
+func Middleware(site *Site) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(WithMetadata(r.Context(), NewMetadata(site))))
+		})
+	}
+}
+
+// SetOpenGraph sets the Open Graph value of the page, replacing the previous one.
+func (md *Metadata) SetOpenGraph(v MetaTagger) {
+	if md == nil {
+		return
+	}
+	md.mu.Lock()
+	defer md.mu.Unlock()
+	md.openGraph = v
+}
+
+// SetTwitterCard sets the Twitter Card of the page, replacing the previous one.
+func (md *Metadata) SetTwitterCard(v MetaTagger) {
+	if md == nil {
+		return
+	}
+	md.mu.Lock()
+	defer md.mu.Unlock()
+	md.twitterCard = v
+}
+
+// AddJsonLd appends JSON-LD entities to the page, e.g. Schema.org entities.
+func (md *Metadata) AddJsonLd(entities ...JsonLdRenderer) {
+	for _, entity := range entities {
+		md.Add(entity)
+	}
+}
+
+// Add appends items to the page. Items are rendered as documented on Head.
+func (md *Metadata) Add(items ...any) {
+	if md == nil {
+		return
+	}
+	md.mu.Lock()
+	defer md.mu.Unlock()
+	for _, item := range items {
+		if isNil(item) {
+			continue
+		}
+		md.items = append(md.items, item)
+	}
+}
+
+// Head returns a Head holding the collected values: the Open Graph value, the Twitter Card and
+// the other items in insertion order. The site defaults are merged into copies of the values,
+// so values shared across requests, e.g. package-level variables, are never modified.
+func (md *Metadata) Head() *Head {
+	if md == nil {
+		return NewHead()
+	}
+	md.mu.Lock()
+	defer md.mu.Unlock()
+
+	items := append([]any{md.openGraph, md.twitterCard}, md.items...)
+	merged := map[any]any{}
+	for i, item := range items {
+		if isNil(item) {
+			continue
+		}
+		// The same value added twice is merged once, so Head still renders its JSON-LD once.
+		if !reflect.TypeOf(item).Comparable() {
+			items[i] = md.site.Merged(item)
+			continue
+		}
+		if _, ok := merged[item]; !ok {
+			merged[item] = md.site.Merged(item)
+		}
+		items[i] = merged[item]
+	}
+	return NewHead(items...)
+}
+
+// Render writes the collected values to w. It makes Metadata a `templ.Component`.
+func (md *Metadata) Render(ctx context.Context, w io.Writer) error {
+	return md.Head().Render(ctx, w)
+}
+
+// ToGoHTML renders the collected values as `template.HTML` value for Go's `html/template`.
+func (md *Metadata) ToGoHTML() (template.HTML, error) {
+	return md.Head().ToGoHTML()
+}
+
+// ContextHead returns a `templ.Component` rendering the Metadata carried by the render context,
+// i.e. the request context when the page is rendered with `templ.Handler` or `Render(r.Context(), w)`.
+// It renders nothing when the context carries no Metadata.
+func ContextHead() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return FromContext(ctx).Render(ctx, w)
+	})
+}
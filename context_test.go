@@ -0,0 +1,128 @@
+package teseo_test
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+	"github.com/indaco/teseo/schemaorg"
+	"github.com/indaco/teseo/twittercard"
+)
+
+// TestMiddleware tests that values set by handlers are rendered by the layout with the site defaults
+func TestMiddleware(t *testing.T) {
+	site := &teseo.Site{Name: "Example", TwitterSite: "@example"}
+
+	handler := teseo.Middleware(site)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := teseo.FromContext(r.Context())
+		md.SetOpenGraph(&opengraph.WebSite{OpenGraphObject: opengraph.OpenGraphObject{Title: "Replaced"}})
+		md.SetOpenGraph(&opengraph.Product{OpenGraphObject: opengraph.OpenGraphObject{Title: "Example Product"}})
+		md.SetTwitterCard(&twittercard.TwitterCard{Title: "Example Product"})
+		md.AddJsonLd(&schemaorg.Product{Name: "Example Product"})
+
+		if err := teseo.ContextHead().Render(r.Context(), w); err != nil {
+			t.Errorf("Render failed: %v", err)
+		}
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/products/1", nil))
+	out := rec.Body.String()
+
+	for _, expected := range []string{
+		`property="og:title" content="Example Product"`,
+		`property="og:site_name" content="Example"`,
+		`name="twitter:site" content="@example"`,
+		`application/ld+json`,
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %s in\n%s", expected, out)
+		}
+	}
+	if strings.Contains(out, "Replaced") {
+		t.Errorf("expected the Open Graph value to be replaced\n%s", out)
+	}
+}
+
+// TestFromContextWithoutMiddleware tests that a missing Metadata is a no-op
+func TestFromContextWithoutMiddleware(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	md := teseo.FromContext(r.Context())
+	md.AddJsonLd(&schemaorg.Product{Name: "Example Product"})
+
+	html, err := md.ToGoHTML()
+	if err != nil || html != "" {
+		t.Errorf("expected empty output, got %q, %v", html, err)
+	}
+}
+
+// TestMetadataInTemplate tests that teseo_head renders the collected values
+func TestMetadataInTemplate(t *testing.T) {
+	md := teseo.NewMetadata(nil)
+	md.SetTwitterCard(&twittercard.TwitterCard{Title: "Example"})
+
+	tmpl := template.Must(template.New("head").Funcs(teseo.FuncMap()).Parse(`{{ teseo_head . }}`))
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, md); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !strings.Contains(sb.String(), `name="twitter:title" content="Example"`) {
+		t.Errorf("expected the Twitter Card, got %s", sb.String())
+	}
+}
+
+// TestMiddlewareSharedValues tests that concurrent requests rendering shared values leave them unchanged
+func TestMiddlewareSharedValues(t *testing.T) {
+	site := &teseo.Site{Name: "Example", Image: "https://www.example.com/share.jpg", TitleTemplate: "%s | Example"}
+	og := &opengraph.WebSite{OpenGraphObject: opengraph.OpenGraphObject{Title: "Home"}}
+	website := &schemaorg.WebSite{}
+	graph := schemaorg.NewGraph("https://www.example.com/", website)
+
+	handler := teseo.Middleware(site)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := teseo.FromContext(r.Context())
+		md.SetOpenGraph(og)
+		md.AddJsonLd(graph, graph)
+		if err := md.Render(r.Context(), w); err != nil {
+			t.Errorf("Render failed: %v", err)
+		}
+	}))
+
+	var wg sync.WaitGroup
+	outputs := make([]string, 2)
+	for i := range outputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			outputs[i] = rec.Body.String()
+		}()
+	}
+	wg.Wait()
+
+	for _, out := range outputs {
+		for _, expected := range []string{
+			`property="og:title" content="Home | Example"`,
+			`property="og:site_name" content="Example"`,
+			`"name":"Example"`,
+		} {
+			if !strings.Contains(out, expected) {
+				t.Errorf("expected %s in\n%s", expected, out)
+			}
+		}
+		if n := strings.Count(out, "application/ld+json"); n != 1 {
+			t.Errorf("expected the graph once, got %d scripts", n)
+		}
+	}
+	if og.Title != "Home" || og.SiteName != "" || og.Image != "" {
+		t.Errorf("rendering modified the shared Open Graph value: %+v", og.OpenGraphObject)
+	}
+	if website.Name != "" || graph.Entities[0] != website {
+		t.Errorf("rendering modified the shared graph: %+v", website)
+	}
+}
@@ -0,0 +1,55 @@
+package teseo
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/a-h/templ"
+)
+
+// RenderError is returned when an entity fails to render.
+// Use errors.As to retrieve it and inspect the type of the failing entity.
+type RenderError struct {
+	Entity string // Entity type, e.g. "schemaorg.Product" or "opengraph.Article"
+	Err    error  // Underlying error
+}
+
+// Error implements the error interface.
+func (e *RenderError) Error() string {
+	return fmt.Sprintf("failed to render %s: %v", e.Entity, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RenderError) Unwrap() error {
+	return e.Err
+}
+
+var logger atomic.Pointer[slog.Logger]
+
+// SetLogger sets the logger used to report rendering failures. Errors are always returned
+// to the caller, the logger only makes them observable. Pass nil to disable logging (default).
+func SetLogger(l *slog.Logger) {
+	logger.Store(l)
+}
+
+// RenderGoHTML renders the templ component as `template.HTML` value for Go's `html/template`.
+// Failures are wrapped in a RenderError for the given entity and reported to the logger set with SetLogger.
+func RenderGoHTML(entity string, c templ.Component) (template.HTML, error) {
+	html, err := templ.ToGoHTML(context.Background(), c)
+	if err != nil {
+		return "", reportRenderError(entity, err)
+	}
+	return html, nil
+}
+
+// reportRenderError wraps err in a RenderError and logs it when a logger is set.
+func reportRenderError(entity string, err error) error {
+	renderErr := &RenderError{Entity: entity, Err: err}
+	if l := logger.Load(); l != nil {
+		l.Error("teseo: render failed", slog.String("entity", entity), slog.Any("error", err))
+	}
+	return renderErr
+}
@@ -0,0 +1,41 @@
+package teseo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// TestRenderGoHTMLError tests that rendering failures are returned as RenderError and logged
+func TestRenderGoHTMLError(t *testing.T) {
+	var buf bytes.Buffer
+	SetLogger(slog.New(slog.NewTextHandler(&buf, nil)))
+	defer SetLogger(nil)
+
+	failure := errors.New("boom")
+	failing := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return failure
+	})
+
+	_, err := RenderGoHTML("schemaorg.Product", failing)
+
+	var renderErr *RenderError
+	if !errors.As(err, &renderErr) {
+		t.Fatalf("expected a *RenderError, got %T", err)
+	}
+	if renderErr.Entity != "schemaorg.Product" {
+		t.Errorf("expected entity schemaorg.Product, got %q", renderErr.Entity)
+	}
+	if !errors.Is(err, failure) {
+		t.Error("expected the underlying error to be wrapped")
+	}
+	if !strings.Contains(buf.String(), "entity=schemaorg.Product") {
+		t.Errorf("expected the failure to be logged, got %q", buf.String())
+	}
+}
@@ -0,0 +1,178 @@
+// Package extract parses HTML documents back into teseo types. It is the reverse of the
+// ToMetaTags and ToJsonLd methods and is useful to migrate legacy pages or audit third-party ones.
+//
+// Example usage:
+//
+//	doc, err := extract.Parse(resp.Body)
+//	if err != nil {
+//		return err
+//	}
+//
+//	if article, ok := doc.OpenGraph.Object.(*opengraph.Article); ok {
+//		fmt.Println(article.Title)
+//	}
+//
+//	for _, entity := range doc.JsonLd {
+//		if product, ok := entity.Entity.(*schemaorg.Product); ok {
+//			fmt.Println(product.Name)
+//		}
+//	}
+package extract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/schemaorg"
+	"github.com/indaco/teseo/twittercard"
+)
+
+// Meta is a meta tag found in the document.
+type Meta struct {
+	Property string // Value of the property or name attribute, e.g. "og:title" or "description"
+	Content  string // Value of the content attribute
+}
+
+// OpenGraph holds the Open Graph metadata found in the document.
+type OpenGraph struct {
+	Type       string                 // og:type, "website" when not specified
+	Object     teseo.MetaTagsRenderer // Decoded value, e.g. *opengraph.Article. Nil when og:type is not supported
+	Properties []Meta                 // Every Open Graph property in document order, including the unsupported ones
+}
+
+// JsonLd is a JSON-LD entity found in an `application/ld+json` script.
+type JsonLd struct {
+	Type   string           // @type of the entity
+	Entity schemaorg.Entity // Decoded value, e.g. *schemaorg.Product or *schemaorg.Generic, see schemaorg.UnmarshalEntity. Nil when Err is set
+	Raw    json.RawMessage  // Original JSON, keeping the properties not supported by the schemaorg types
+	Err    error            // Set when the script does not contain valid JSON or the entity is not a JSON object
+}
+
+// Document holds the metadata found in an HTML document.
+type Document struct {
+	OpenGraph   *OpenGraph               // Nil when the document has no Open Graph properties
+	TwitterCard *twittercard.TwitterCard // Nil when the document has no twitter:* properties
+	JsonLd      []JsonLd                 // Entities from every `application/ld+json` script, in document order
+	Meta        []Meta                   // Every meta tag with a property or name attribute, in document order
+}
+
+// Parse reads an HTML document and returns the metadata it contains.
+func Parse(r io.Reader) (*Document, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HTML document: %w", err)
+	}
+
+	doc := &Document{}
+	for _, t := range scanTags(string(data)) {
+		switch t.name {
+		case "meta":
+			property := t.attrs["property"]
+			if property == "" {
+				property = t.attrs["name"]
+			}
+			if property == "" {
+				continue
+			}
+			doc.Meta = append(doc.Meta, Meta{Property: property, Content: t.attrs["content"]})
+		case "script":
+			if !strings.EqualFold(strings.TrimSpace(t.attrs["type"]), "application/ld+json") {
+				continue
+			}
+			doc.JsonLd = append(doc.JsonLd, decodeJsonLd([]byte(t.text))...)
+		}
+	}
+
+	var ogProps, twitterProps []Meta
+	namespace := openGraphNamespace(doc.Meta)
+	for _, meta := range doc.Meta {
+		switch {
+		case strings.HasPrefix(meta.Property, "twitter:"):
+			twitterProps = append(twitterProps, meta)
+		case isOpenGraphProperty(meta.Property), namespace != "" && strings.HasPrefix(meta.Property, namespace):
+			ogProps = append(ogProps, meta)
+		}
+	}
+
+	if len(ogProps) > 0 {
+		doc.OpenGraph = decodeOpenGraph(ogProps)
+	}
+
+	if len(twitterProps) > 0 {
+		doc.TwitterCard = decodeTwitterCard(twitterProps)
+	}
+
+	return doc, nil
+}
+
+// decodeTwitterCard maps the twitter:* properties on a TwitterCard.
+func decodeTwitterCard(props []Meta) *twittercard.TwitterCard {
+	card := &twittercard.TwitterCard{}
+	fields := map[string]*string{
+		"twitter:title":         &card.Title,
+		"twitter:description":   &card.Description,
+		"twitter:image":         &card.Image,
+		"twitter:site":          &card.Site,
+		"twitter:creator":       &card.Creator,
+		"twitter:app:id:iphone": &card.AppID,
+		"twitter:player":        &card.PlayerURL,
+	}
+	for _, prop := range props {
+		if prop.Property == "twitter:card" {
+			card.Card = twittercard.TwitterCardType(prop.Content)
+			continue
+		}
+		if field, ok := fields[prop.Property]; ok && *field == "" {
+			*field = prop.Content
+		}
+	}
+	return card
+}
+
+// decodeJsonLd decodes the content of a JSON-LD script holding an entity, an array of entities or a `@graph`.
+func decodeJsonLd(data []byte) []JsonLd {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+
+	raws, err := schemaorg.SplitJsonLd(data)
+	if err != nil {
+		return []JsonLd{{Raw: data, Err: err}}
+	}
+
+	entities := make([]JsonLd, 0, len(raws))
+	for _, raw := range raws {
+		decoded, err := schemaorg.UnmarshalEntity(raw)
+		if err != nil {
+			entities = append(entities, JsonLd{Raw: raw, Err: fmt.Errorf("failed to decode JSON-LD: %w", err)})
+			continue
+		}
+
+		// raw is a JSON object, UnmarshalEntity decoded it.
+		var head struct {
+			Type json.RawMessage `json:"@type"`
+		}
+		json.Unmarshal(raw, &head)
+		entities = append(entities, JsonLd{Type: firstType(head.Type), Entity: decoded, Raw: raw})
+	}
+	return entities
+}
+
+// firstType returns the @type value, or the first one when @type is an array.
+func firstType(raw json.RawMessage) string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return single
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(raw, &multiple); err == nil && len(multiple) > 0 {
+		return multiple[0]
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package extract
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+	"github.com/indaco/teseo/schemaorg"
+	"github.com/indaco/teseo/twittercard"
+)
+
+// TestParseRoundTrip tests that the output of a Head is parsed back into the same values
+func TestParseRoundTrip(t *testing.T) {
+	article := &opengraph.Article{
+		OpenGraphObject: opengraph.OpenGraphObject{
+			Type:  "article",
+			Title: "Example & Co",
+			URL:   "https://www.example.com/article",
+		},
+		Author: []string{"https://www.example.com/jane"},
+		Tag:    []string{"go", "seo"},
+	}
+	card := &twittercard.TwitterCard{Card: twittercard.CardSummary, Title: "Card Title", Site: "@example"}
+	product := &schemaorg.Product{Name: "Example Product", SKU: "12345"}
+
+	html, err := teseo.NewHead(article, card, product).ToGoHTML()
+	if err != nil {
+		t.Fatalf("ToGoHTML failed: %v", err)
+	}
+
+	doc, err := Parse(strings.NewReader("<html><head>" + string(html) + "</head></html>"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	got, ok := doc.OpenGraph.Object.(*opengraph.Article)
+	if !ok {
+		t.Fatalf("expected an *opengraph.Article, got %T", doc.OpenGraph.Object)
+	}
+	if got.Title != article.Title || got.URL != article.URL || len(got.Tag) != 2 || got.Author[0] != article.Author[0] {
+		t.Errorf("unexpected article: %+v", got)
+	}
+
+	if doc.TwitterCard == nil || doc.TwitterCard.Title != "Card Title" || doc.TwitterCard.Site != "@example" {
+		t.Errorf("unexpected twitter card: %+v", doc.TwitterCard)
+	}
+
+	if len(doc.JsonLd) != 1 {
+		t.Fatalf("expected one JSON-LD entity, got %d", len(doc.JsonLd))
+	}
+	if p, ok := doc.JsonLd[0].Entity.(*schemaorg.Product); !ok || p.Name != "Example Product" || p.SKU != "12345" {
+		t.Errorf("unexpected JSON-LD entity: %+v", doc.JsonLd[0].Entity)
+	}
+}
+
+// TestParseFallbacks tests that unknown types and properties are preserved
+func TestParseFallbacks(t *testing.T) {
+	const page = `<!DOCTYPE html>
+<html>
+<head>
+  <!-- <meta property="og:title" content="commented out"> -->
+  <META NAME="description" CONTENT='A page'>
+  <meta property="og:type" content="fitness.course">
+  <meta property="og:title" content=Course>
+  <meta property="fitness:duration:value" content="30">
+  <script type="application/ld+json">
+    [{"@context": "https://schema.org", "@type": "Recipe", "name": "Pizza"},
+     {"@context": "https://schema.org", "@type": "Person", "name": "Jane", "knowsAbout": "Go"}]
+  </script>
+  <script type="application/ld+json">{ not json </script>
+</head>
+</html>`
+
+	doc, err := Parse(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(doc.Meta) != 4 || doc.Meta[0].Property != "description" || doc.Meta[0].Content != "A page" {
+		t.Errorf("unexpected meta tags: %+v", doc.Meta)
+	}
+
+	if doc.OpenGraph.Type != "fitness.course" || doc.OpenGraph.Object != nil || len(doc.OpenGraph.Properties) != 3 {
+		t.Errorf("expected a generic Open Graph fallback, got %+v", doc.OpenGraph)
+	}
+
+	if len(doc.JsonLd) != 3 {
+		t.Fatalf("expected three JSON-LD entries, got %d", len(doc.JsonLd))
+	}
+	if recipe := doc.JsonLd[0]; recipe.Type != "Recipe" || !strings.Contains(string(recipe.Raw), "Pizza") {
+		t.Errorf("expected a Recipe keeping its raw JSON, got %+v", recipe)
+	}
+	if _, ok := doc.JsonLd[0].Entity.(*schemaorg.Generic); !ok {
+		t.Errorf("expected a generic Recipe fallback, got %T", doc.JsonLd[0].Entity)
+	}
+	if person := doc.JsonLd[1]; person.Entity == nil || !strings.Contains(string(person.Raw), "knowsAbout") {
+		t.Errorf("expected a Person keeping its raw JSON, got %+v", person)
+	}
+	if doc.JsonLd[2].Err == nil {
+		t.Error("expected an error for the invalid JSON-LD script")
+	}
+}
+
+// TestParseGraphInArray tests that a `@graph` nested in an array is expanded like schemaorg.Unmarshal does
+func TestParseGraphInArray(t *testing.T) {
+	const page = `<script type="application/ld+json">
+    [{"@context": "https://schema.org", "@graph": [
+        {"@type": "Organization", "name": "Example Inc."},
+        {"@type": "Product", "name": "Example Product", "offers": [{"@type": "Offer", "price": 29.99}]}
+     ]},
+     {"@type": "WebSite", "name": "Example"}]
+  </script>`
+
+	doc, err := Parse(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(doc.JsonLd) != 3 {
+		t.Fatalf("expected three JSON-LD entries, got %d", len(doc.JsonLd))
+	}
+	if org, ok := doc.JsonLd[0].Entity.(*schemaorg.Organization); !ok || doc.JsonLd[0].Type != "Organization" || org.Name != "Example Inc." {
+		t.Errorf("expected an Organization, got %+v", doc.JsonLd[0])
+	}
+	if product, ok := doc.JsonLd[1].Entity.(*schemaorg.Product); !ok || product.Offers == nil || product.Offers.Price != "29.99" {
+		t.Errorf("expected a Product, got %+v", doc.JsonLd[1])
+	}
+	if _, ok := doc.JsonLd[2].Entity.(*schemaorg.WebSite); !ok {
+		t.Errorf("expected a WebSite, got %+v", doc.JsonLd[2])
+	}
+}
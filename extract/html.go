@@ -0,0 +1,145 @@
+package extract
+
+import (
+	"html"
+	"strings"
+)
+
+// tag is an HTML start tag with its attributes. For script elements text holds the raw content.
+type tag struct {
+	name  string
+	attrs map[string]string
+	text  string
+}
+
+// scanTags returns the meta and script tags of the HTML document in order.
+// It is a small tokenizer handling comments, quoted and unquoted attributes, and raw text elements.
+func scanTags(doc string) []tag {
+	var tags []tag
+	i := 0
+	for {
+		lt := strings.IndexByte(doc[i:], '<')
+		if lt < 0 {
+			return tags
+		}
+		i += lt + 1
+		if i >= len(doc) {
+			return tags
+		}
+
+		switch {
+		case strings.HasPrefix(doc[i:], "!--"):
+			end := strings.Index(doc[i+3:], "-->")
+			if end < 0 {
+				return tags
+			}
+			i += 3 + end + 3
+			continue
+		case doc[i] == '!' || doc[i] == '?' || doc[i] == '/':
+			end := strings.IndexByte(doc[i:], '>')
+			if end < 0 {
+				return tags
+			}
+			i += end + 1
+			continue
+		case !isLetter(doc[i]):
+			continue
+		}
+
+		t, next := scanStartTag(doc, i)
+		i = next
+
+		switch t.name {
+		case "script", "style", "textarea", "title":
+			closing := "</" + t.name
+			end := indexFold(doc[i:], closing)
+			if end < 0 {
+				end = len(doc) - i
+			}
+			t.text = doc[i : i+end]
+			i += end
+		}
+
+		if t.name == "meta" || t.name == "script" {
+			tags = append(tags, t)
+		}
+	}
+}
+
+// scanStartTag parses the start tag whose name begins at doc[i] and returns the position after it.
+func scanStartTag(doc string, i int) (tag, int) {
+	start := i
+	for i < len(doc) && !isSpace(doc[i]) && doc[i] != '>' && doc[i] != '/' {
+		i++
+	}
+	t := tag{name: strings.ToLower(doc[start:i]), attrs: map[string]string{}}
+
+	for i < len(doc) {
+		for i < len(doc) && (isSpace(doc[i]) || doc[i] == '/') {
+			i++
+		}
+		if i >= len(doc) {
+			break
+		}
+		if doc[i] == '>' {
+			i++
+			break
+		}
+
+		nameStart := i
+		for i < len(doc) && !isSpace(doc[i]) && doc[i] != '=' && doc[i] != '>' && doc[i] != '/' {
+			i++
+		}
+		name := strings.ToLower(doc[nameStart:i])
+
+		for i < len(doc) && isSpace(doc[i]) {
+			i++
+		}
+		value := ""
+		if i < len(doc) && doc[i] == '=' {
+			i++
+			for i < len(doc) && isSpace(doc[i]) {
+				i++
+			}
+			if i < len(doc) && (doc[i] == '"' || doc[i] == '\'') {
+				quote := doc[i]
+				end := strings.IndexByte(doc[i+1:], quote)
+				if end < 0 {
+					end = len(doc) - i - 1
+				}
+				value = doc[i+1 : i+1+end]
+				i += end + 2
+			} else {
+				valueStart := i
+				for i < len(doc) && !isSpace(doc[i]) && doc[i] != '>' {
+					i++
+				}
+				value = doc[valueStart:i]
+			}
+		}
+
+		if _, exists := t.attrs[name]; !exists && name != "" {
+			t.attrs[name] = html.UnescapeString(value)
+		}
+	}
+
+	return t, min(i, len(doc))
+}
+
+// indexFold returns the index of the first case-insensitive instance of substr in s, or -1.
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isLetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
@@ -0,0 +1,266 @@
+package extract
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+)
+
+// openGraphPrefixes lists the namespaces of the Open Graph properties.
+var openGraphPrefixes = []string{
+	"og:", "article:", "book:", "business:", "event:", "music:",
+	"place:", "product:", "profile:", "restaurant:", "video:",
+}
+
+// isOpenGraphProperty reports whether property belongs to an Open Graph namespace.
+func isOpenGraphProperty(property string) bool {
+	for _, prefix := range openGraphPrefixes {
+		if strings.HasPrefix(property, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// openGraphNamespace returns the namespace of the og:type, e.g. "fitness:" for "fitness.course",
+// so the properties of types unknown to teseo are kept too.
+func openGraphNamespace(metas []Meta) string {
+	for _, meta := range metas {
+		if meta.Property == "og:type" && meta.Content != "" {
+			namespace, _, _ := strings.Cut(meta.Content, ".")
+			return namespace + ":"
+		}
+	}
+	return ""
+}
+
+// fieldMap maps Open Graph properties on the fields of a value.
+type fieldMap struct {
+	strings map[string]*string
+	lists   map[string]*[]string
+	floats  map[string]*float64
+	ints    map[string]*int
+}
+
+// newFieldMap returns a fieldMap holding the basic Open Graph properties of og.
+func newFieldMap(og *opengraph.OpenGraphObject) fieldMap {
+	return fieldMap{
+		strings: map[string]*string{
+			"og:title":       &og.Title,
+			"og:url":         &og.URL,
+			"og:description": &og.Description,
+			"og:image":       &og.Image,
+			"og:site_name":   &og.SiteName,
+			"og:locale":      &og.Locale,
+		},
+		lists:  map[string]*[]string{},
+		floats: map[string]*float64{},
+		ints:   map[string]*int{},
+	}
+}
+
+// assign sets the fields mapped for props. Single-valued fields keep the first occurrence,
+// numeric fields are skipped when the content does not parse.
+func (fm fieldMap) assign(props []Meta) {
+	assigned := map[string]bool{}
+	for _, prop := range props {
+		if list, ok := fm.lists[prop.Property]; ok {
+			*list = append(*list, prop.Content)
+			continue
+		}
+		if assigned[prop.Property] {
+			continue
+		}
+		assigned[prop.Property] = true
+
+		if field, ok := fm.strings[prop.Property]; ok {
+			*field = prop.Content
+		}
+		if field, ok := fm.floats[prop.Property]; ok {
+			if f, err := strconv.ParseFloat(prop.Content, 64); err == nil {
+				*field = f
+			}
+		}
+		if field, ok := fm.ints[prop.Property]; ok {
+			if i, err := strconv.Atoi(prop.Content); err == nil {
+				*field = i
+			}
+		}
+	}
+}
+
+// decodeOpenGraph maps the Open Graph properties on the value matching og:type.
+func decodeOpenGraph(props []Meta) *OpenGraph {
+	ogType := "website"
+	for _, prop := range props {
+		if prop.Property == "og:type" && prop.Content != "" {
+			ogType = prop.Content
+			break
+		}
+	}
+
+	result := &OpenGraph{Type: ogType, Properties: props}
+	object, fields := newOpenGraphObject(ogType)
+	if object == nil {
+		return result
+	}
+
+	fields.assign(props)
+	result.Object = object
+	return result
+}
+
+// newOpenGraphObject returns an empty value for the og:type and the mapping of its properties.
+// It returns a nil value when the og:type is not supported.
+func newOpenGraphObject(ogType string) (teseo.MetaTagsRenderer, fieldMap) {
+	var object teseo.MetaTagsRenderer
+	var fm fieldMap
+
+	switch ogType {
+	case "article":
+		v := &opengraph.Article{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["article:published_time"] = &v.PublishedTime
+		fm.strings["article:modified_time"] = &v.ModifiedTime
+		fm.strings["article:expiration_time"] = &v.ExpirationTime
+		fm.strings["article:section"] = &v.Section
+		fm.lists["article:author"] = &v.Author
+		fm.lists["article:tag"] = &v.Tag
+	case "music.audio":
+		v := &opengraph.Audio{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["music:duration"] = &v.Duration
+		fm.strings["music:musician"] = &v.ArtistURL
+	case "book":
+		v := &opengraph.Book{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["book:isbn"] = &v.ISBN
+		fm.strings["book:release_date"] = &v.ReleaseDate
+		fm.lists["book:author"] = &v.Author
+		fm.lists["book:tag"] = &v.Tag
+	case "business.business":
+		v := &opengraph.Business{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["business:contact_data:street_address"] = &v.StreetAddress
+		fm.strings["business:contact_data:locality"] = &v.Locality
+		fm.strings["business:contact_data:region"] = &v.Region
+		fm.strings["business:contact_data:postal_code"] = &v.PostalCode
+		fm.strings["business:contact_data:country_name"] = &v.Country
+		fm.strings["business:contact_data:email"] = &v.Email
+		fm.strings["business:contact_data:phone_number"] = &v.PhoneNumber
+		fm.strings["business:contact_data:website"] = &v.Website
+	case "event":
+		v := &opengraph.Event{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["event:start_date"] = &v.StartDate
+		fm.strings["event:end_date"] = &v.EndDate
+		fm.strings["event:location"] = &v.Location
+	case "music.album":
+		v := &opengraph.MusicAlbum{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["music:release_date"] = &v.ReleaseDate
+		fm.strings["music:genre"] = &v.Genre
+		fm.lists["music:musician"] = &v.Musician
+	case "music.playlist":
+		v := &opengraph.MusicPlaylist{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["music:duration"] = &v.Duration
+		fm.lists["music:song"] = &v.SongURLs
+	case "music.radio_station":
+		v := &opengraph.MusicRadioStation{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+	case "music.song":
+		v := &opengraph.MusicSong{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["music:duration"] = &v.Duration
+		fm.strings["music:album"] = &v.AlbumURL
+		fm.lists["music:musician"] = &v.MusicianURLs
+	case "place":
+		v := &opengraph.Place{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["place:contact_data:street_address"] = &v.StreetAddress
+		fm.strings["place:contact_data:locality"] = &v.Locality
+		fm.strings["place:contact_data:region"] = &v.Region
+		fm.strings["place:contact_data:postal_code"] = &v.PostalCode
+		fm.strings["place:contact_data:country_name"] = &v.Country
+		fm.floats["place:location:latitude"] = &v.Latitude
+		fm.floats["place:location:longitude"] = &v.Longitude
+	case "product":
+		v := &opengraph.Product{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["product:price:amount"] = &v.Price
+		fm.strings["product:price:currency"] = &v.PriceCurrency
+	case "product.group":
+		v := &opengraph.ProductGroup{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.lists["product:group_item"] = &v.Products
+	case "profile":
+		v := &opengraph.Profile{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["profile:first_name"] = &v.FirstName
+		fm.strings["profile:last_name"] = &v.LastName
+		fm.strings["profile:username"] = &v.Username
+		fm.strings["profile:gender"] = &v.Gender
+	case "restaurant", "restaurant.restaurant":
+		v := &opengraph.Restaurant{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["place:contact_data:street_address"] = &v.StreetAddress
+		fm.strings["place:contact_data:locality"] = &v.Locality
+		fm.strings["place:contact_data:region"] = &v.Region
+		fm.strings["place:contact_data:postal_code"] = &v.PostalCode
+		fm.strings["place:contact_data:country_name"] = &v.Country
+		fm.strings["place:contact_data:phone_number"] = &v.Phone
+		fm.strings["restaurant:menu"] = &v.MenuURL
+		fm.strings["restaurant:reservation"] = &v.ReservationURL
+	case "video.other":
+		v := &opengraph.Video{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["video:duration"] = &v.Duration
+		fm.strings["video:director"] = &v.DirectorURL
+		fm.strings["video:release_date"] = &v.ReleaseDate
+		fm.lists["video:actor"] = &v.ActorURLs
+	case "video.episode":
+		v := &opengraph.VideoEpisode{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["video:series"] = &v.SeriesURL
+		fm.strings["video:duration"] = &v.Duration
+		fm.strings["video:director"] = &v.DirectorURL
+		fm.strings["video:release_date"] = &v.ReleaseDate
+		fm.lists["video:actor"] = &v.ActorURLs
+		fm.ints["video:episode"] = &v.EpisodeNumber
+	case "video.movie":
+		v := &opengraph.VideoMovie{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+		fm.strings["video:duration"] = &v.Duration
+		fm.strings["video:director"] = &v.DirectorURL
+		fm.strings["video:release_date"] = &v.ReleaseDate
+		fm.lists["video:actor"] = &v.ActorURLs
+	case "website":
+		v := &opengraph.WebSite{}
+		object, fm = v, newFieldMap(&v.OpenGraphObject)
+		v.Type = ogType
+	default:
+		return nil, fieldMap{}
+	}
+
+	return object, fm
+}
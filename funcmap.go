@@ -0,0 +1,64 @@
+package teseo
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// FuncMap returns the functions rendering teseo values from Go's `html/template`, without going through templ:
+//
+//   - teseo_jsonld renders the JSON-LD scripts of the given Schema.org values.
+//   - teseo_meta renders the meta tags of the given Open Graph, Twitter Card or Tags values.
+//   - teseo_head renders the given values as a Head.
+//
+// Nil values are skipped.
+//
+// Example usage:
+//
+//	tmpl := template.Must(template.New("page").Funcs(teseo.FuncMap()).Parse(`
+//		<head>
+//			{{ teseo_meta .Article .Card }}
+//			{{ teseo_jsonld .Product }}
+//		</head>
+//	`))
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"teseo_jsonld": templateJsonLd,
+		"teseo_meta":   templateMeta,
+		"teseo_head":   templateHead,
+	}
+}
+
+// templateJsonLd renders items as `template.HTML` value.
+func templateJsonLd(items ...WriterRenderer) (template.HTML, error) {
+	var sb strings.Builder
+	for _, item := range items {
+		if isNil(item) {
+			continue
+		}
+		if err := item.Render(&sb); err != nil {
+			return "", reportRenderError(fmt.Sprintf("%T", item), err)
+		}
+	}
+	return template.HTML(sb.String()), nil
+}
+
+// templateMeta renders the meta tags of items as `template.HTML` value.
+func templateMeta(items ...MetaTagger) (template.HTML, error) {
+	var sb strings.Builder
+	for _, item := range items {
+		if isNil(item) {
+			continue
+		}
+		if err := WriteMetaTags(&sb, item); err != nil {
+			return "", reportRenderError(fmt.Sprintf("%T", item), err)
+		}
+	}
+	return template.HTML(sb.String()), nil
+}
+
+// templateHead renders items as a Head.
+func templateHead(items ...any) (template.HTML, error) {
+	return NewHead(items...).ToGoHTML()
+}
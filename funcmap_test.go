@@ -0,0 +1,68 @@
+package teseo_test
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+	"github.com/indaco/teseo/schemaorg"
+	"github.com/indaco/teseo/twittercard"
+)
+
+// TestFuncMap tests that the template functions render the same output as the templ components
+func TestFuncMap(t *testing.T) {
+	article := opengraph.NewArticle("Example", "https://www.example.com/article", "Description", "https://www.example.com/image.jpg", "", "", "", nil, "", []string{"go"})
+	card := twittercard.NewSummaryCard("Example", "Description", "", "@example", "")
+	product := &schemaorg.Product{Name: "Example <Product>"}
+	var missing *schemaorg.Product
+
+	tmpl := template.Must(template.New("page").Funcs(teseo.FuncMap()).Parse(
+		`{{ teseo_meta .Article .Card }}|{{ teseo_jsonld .Product .Missing }}`))
+
+	var sb strings.Builder
+	data := map[string]any{"Article": article, "Card": card, "Product": product, "Missing": missing}
+	if err := tmpl.Execute(&sb, data); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	articleHtml, _ := article.ToGoHTMLMetaTags()
+	cardHtml, _ := card.ToGoHTMLMetaTags()
+	productHtml, _ := product.ToGoHTMLJsonLd()
+	expected := string(articleHtml) + string(cardHtml) + "|" + string(productHtml)
+	if sb.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, sb.String())
+	}
+}
+
+// TestRenderWithoutTempl tests that Render writes the same output as the templ components
+func TestRenderWithoutTempl(t *testing.T) {
+	graph := schemaorg.NewGraph("https://www.example.com/", &schemaorg.Organization{Name: "Example"})
+	website := opengraph.NewWebSite("Example", "https://www.example.com", "", "")
+	card := twittercard.NewSummaryCard("Example", "", "", "", "")
+
+	tests := []struct {
+		name     string
+		value    teseo.WriterRenderer
+		toGoHTML func() (template.HTML, error)
+	}{
+		{"schemaorg.Graph", graph, graph.ToGoHTMLJsonLd},
+		{"opengraph.WebSite", website, website.ToGoHTMLMetaTags},
+		{"twittercard.TwitterCard", card, card.ToGoHTMLMetaTags},
+	}
+
+	for _, tt := range tests {
+		var sb strings.Builder
+		if err := tt.value.Render(&sb); err != nil {
+			t.Fatalf("%s: Render failed: %v", tt.name, err)
+		}
+		expected, err := tt.toGoHTML()
+		if err != nil {
+			t.Fatalf("%s: ToGoHTML failed: %v", tt.name, err)
+		}
+		if sb.String() != string(expected) {
+			t.Errorf("%s: expected %s, got %s", tt.name, expected, sb.String())
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package teseo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/a-h/templ"
+)
+
+// MetaTagsRenderer is the interface implemented by types rendering HTML meta tags, e.g. Open Graph and Twitter Cards.
+type MetaTagsRenderer interface {
+	ToMetaTags() templ.Component
+}
+
+// LinkTagsRenderer is the interface implemented by types rendering HTML link tags, e.g. links.Page.
+type LinkTagsRenderer interface {
+	ToLinkTags() templ.Component
+}
+
+// Titler is the interface implemented by types providing the page title, e.g. meta.Page.
+// Head renders the title of the first Titler as `<title>` element.
+type Titler interface {
+	PageTitle() string
+}
+
+// JsonLdRenderer is the interface implemented by types rendering a JSON-LD script, e.g. the Schema.org entities.
+type JsonLdRenderer interface {
+	ToJsonLd() templ.Component
+}
+
+// Head aggregates Open Graph, Twitter Card and Schema.org values and renders them together
+// as the SEO section of an HTML page head.
+//
+// The `<title>` element and the link tags (e.g. the canonical URL) are rendered first. Meta tags
+// follow, ordered as follows: standard metadata names (e.g. `description`, `robots`) first, then
+// `og:*` properties, then the type specific Open Graph properties (e.g. `article:*`, `book:*`,
+// `music:*`), then `twitter:*` properties. The JSON-LD scripts come last. Within each group the insertion order is preserved.
+//
+// Duplicates are removed with a "first source wins" rule: when two values emit the same
+// meta property (e.g. two `og:title`), only the tags from the value added first are kept.
+// Multi-valued properties (e.g. several `article:tag`) coming from that same value are all kept.
+// The same JSON-LD entity added more than once is rendered only once.
+//
+// HTML written by a MetaTagsRenderer without WriteMeta, e.g. its own `<meta>` markup, is kept as is
+// and placed with the meta tags the same value emitted before it.
+//
+// Example usage:
+//
+//	head := teseo.NewHead(
+//		&opengraph.Article{...},
+//		&twittercard.TwitterCard{...},
+//		&schemaorg.Article{...},
+//		&schemaorg.BreadcrumbList{...},
+//	)
+//
+// // Rendering using templ:
+//
+//	templ Page() {
+//		<head>
+//			@head
+//		</head>
+//	}
+//
+// // Rendering as `template.HTML` value:
+//
+//	headHtml, err := head.ToGoHTML()
+type Head struct {
+	items []any
+}
+
+// NewHead initializes a Head with the provided items.
+func NewHead(items ...any) *Head {
+	return (&Head{}).Add(items...)
+}
+
+// Add appends items to the Head. Each item must implement at least one of MetaTagger, MetaTagsRenderer,
+// LinkTagsRenderer, JsonLdRenderer and Titler.
+// Nil values are ignored, so optional fields of a page model can be passed as they are.
+// A *Metadata adds the values collected so far.
+func (h *Head) Add(items ...any) *Head {
+	for _, item := range items {
+		if isNil(item) {
+			continue
+		}
+		if md, ok := item.(*Metadata); ok {
+			h.items = append(h.items, md.Head().items...)
+			continue
+		}
+		h.items = append(h.items, item)
+	}
+	return h
+}
+
+// Render writes the meta tags and JSON-LD scripts of all items to w. It makes Head a `templ.Component`.
+func (h *Head) Render(ctx context.Context, w io.Writer) error {
+	collector := &metaTagBuffer{}
+	var links, scripts []templ.Component
+	seen := map[any]bool{}
+	title := ""
+
+	for i, item := range h.items {
+		tagger, isTagger := item.(MetaTagger)
+		metaRenderer, isMeta := item.(MetaTagsRenderer)
+		linkRenderer, isLink := item.(LinkTagsRenderer)
+		jsonLdRenderer, isJsonLd := item.(JsonLdRenderer)
+		titler, isTitler := item.(Titler)
+		if !isTagger && !isMeta && !isLink && !isJsonLd && !isTitler {
+			return fmt.Errorf("unsupported head item of type %T", item)
+		}
+
+		if isTitler && title == "" {
+			title = titler.PageTitle()
+		}
+
+		collector.source = i
+		switch {
+		case isTagger:
+			for _, tag := range tagger.Tags() {
+				collector.collectMetaTag(tag)
+			}
+		case isMeta:
+			if err := metaRenderer.ToMetaTags().Render(ctx, collector); err != nil {
+				return err
+			}
+		}
+
+		if isLink {
+			links = append(links, linkRenderer.ToLinkTags())
+		}
+
+		if isJsonLd {
+			if reflect.TypeOf(item).Comparable() {
+				if seen[item] {
+					continue
+				}
+				seen[item] = true
+			}
+			scripts = append(scripts, jsonLdRenderer.ToJsonLd())
+		}
+	}
+
+	if err := WriteTitle(w, title); err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if err := link.Render(ctx, w); err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range collector.tags() {
+		if tag.raw != nil {
+			if _, err := w.Write(tag.raw); err != nil {
+				return fmt.Errorf("failed to write meta tags: %w", err)
+			}
+			continue
+		}
+		if err := WriteMeta(w, tag.MetaTag); err != nil {
+			return err
+		}
+	}
+
+	for _, script := range scripts {
+		if err := script.Render(ctx, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ToGoHTML renders the Head as `template.HTML` value for Go's `html/template`.
+func (h *Head) ToGoHTML() (template.HTML, error) {
+	return RenderGoHTML("teseo.Head", h)
+}
+
+// metaTagCollector is implemented by writers gathering the meta tags passed to WriteMeta
+// instead of writing them as HTML.
+type metaTagCollector interface {
+	collectMetaTag(tag MetaTag)
+}
+
+// collectedMetaTag is a meta tag gathered by metaTagBuffer along with the index of the item emitting it
+// and the rank of its group. raw holds the HTML written without WriteMeta, rendered as is.
+type collectedMetaTag struct {
+	MetaTag
+	source int
+	group  int
+	raw    []byte
+}
+
+// metaTagBuffer gathers meta tags for the Head.
+type metaTagBuffer struct {
+	source  int
+	entries []collectedMetaTag
+}
+
+// Write keeps the HTML which is not a meta tag written via WriteMeta, in the group of the previous
+// meta tag of the same item. Whitespace between tags is dropped.
+func (b *metaTagBuffer) Write(p []byte) (int, error) {
+	if len(bytes.TrimSpace(p)) == 0 {
+		return len(p), nil
+	}
+	group := 2
+	if n := len(b.entries); n > 0 && b.entries[n-1].source == b.source {
+		group = b.entries[n-1].group
+	}
+	b.entries = append(b.entries, collectedMetaTag{source: b.source, group: group, raw: bytes.Clone(p)})
+	return len(p), nil
+}
+
+func (b *metaTagBuffer) collectMetaTag(tag MetaTag) {
+	b.entries = append(b.entries, collectedMetaTag{MetaTag: tag, source: b.source, group: metaTagGroup(tag)})
+}
+
+// tags returns the gathered meta tags deduplicated and ordered as documented on Head.
+func (b *metaTagBuffer) tags() []collectedMetaTag {
+	type tagKey struct {
+		attribute MetaAttribute
+		key       string
+	}
+	owner := map[tagKey]int{}
+	var result []collectedMetaTag
+	for _, entry := range b.entries {
+		if entry.raw != nil {
+			result = append(result, entry)
+			continue
+		}
+		k := tagKey{entry.attribute(), entry.Key}
+		if source, ok := owner[k]; ok && source != entry.source {
+			continue
+		}
+		owner[k] = entry.source
+		result = append(result, entry)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].group < result[j].group
+	})
+
+	return result
+}
+
+// metaTagGroup returns the rank of the tag's group in the Head output.
+func metaTagGroup(tag MetaTag) int {
+	switch {
+	case strings.HasPrefix(tag.Key, "twitter:"):
+		return 3
+	case tag.attribute() == MetaName || tag.attribute() == MetaHTTPEquiv:
+		return 0
+	case strings.HasPrefix(tag.Key, "og:"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// isNil reports whether v is nil or a nil pointer, map, slice or interface.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package teseo_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+	"github.com/indaco/teseo/schemaorg"
+	"github.com/indaco/teseo/twittercard"
+)
+
+// TestHeadOrderAndDeduplication tests the ordering and the "first source wins" rule of Head
+func TestHeadOrderAndDeduplication(t *testing.T) {
+	article := &opengraph.Article{
+		OpenGraphObject: opengraph.OpenGraphObject{
+			Title: "Article Title",
+			URL:   "https://www.example.com/article",
+		},
+		Tag: []string{"go", "seo"},
+	}
+	website := &opengraph.WebSite{
+		OpenGraphObject: opengraph.OpenGraphObject{
+			Title:       "Website Title",
+			Description: "Website description",
+		},
+	}
+	card := &twittercard.TwitterCard{Card: twittercard.CardSummary, Title: "Card Title"}
+	page := &schemaorg.WebPage{Name: "Example WebPage"}
+
+	var nilCrumbs *schemaorg.BreadcrumbList
+	head := teseo.NewHead(card, article, website, page, page, nilCrumbs)
+
+	html, err := head.ToGoHTML()
+	if err != nil {
+		t.Fatalf("ToGoHTML failed: %v", err)
+	}
+	out := string(html)
+
+	if got := strings.Count(out, `property="og:title"`); got != 1 {
+		t.Errorf("expected a single og:title, got %d\n%s", got, out)
+	}
+	if !strings.Contains(out, `property="og:title" content="Article Title"`) {
+		t.Errorf("expected og:title from the first source\n%s", out)
+	}
+	if !strings.Contains(out, `property="og:description" content="Website description"`) {
+		t.Errorf("expected og:description from the second source\n%s", out)
+	}
+	if got := strings.Count(out, `property="article:tag"`); got != 2 {
+		t.Errorf("expected both article:tag values, got %d\n%s", got, out)
+	}
+	if got := strings.Count(out, "application/ld+json"); got != 1 {
+		t.Errorf("expected a single JSON-LD script, got %d\n%s", got, out)
+	}
+
+	ogIdx := strings.Index(out, "og:title")
+	articleIdx := strings.Index(out, "article:tag")
+	twitterIdx := strings.Index(out, "twitter:card")
+	scriptIdx := strings.Index(out, "<script")
+	if !(ogIdx < articleIdx && articleIdx < twitterIdx && twitterIdx < scriptIdx) {
+		t.Errorf("unexpected tag order\n%s", out)
+	}
+}
+
+// TestHeadUnsupportedItem tests that Head reports values it cannot render
+func TestHeadUnsupportedItem(t *testing.T) {
+	if _, err := teseo.NewHead("not an SEO value").ToGoHTML(); err == nil {
+		t.Error("expected an error for an unsupported item")
+	}
+}
+
+// customMetaTags renders its meta tags without the teseo helpers
+type customMetaTags struct{}
+
+func (customMetaTags) ToMetaTags() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, `<meta name="theme-color" content="#ffffff" />`)
+		return err
+	})
+}
+
+// TestHeadCustomMetaTagsRenderer tests that Head keeps meta tags written without WriteMeta
+func TestHeadCustomMetaTagsRenderer(t *testing.T) {
+	og := &opengraph.WebSite{OpenGraphObject: opengraph.OpenGraphObject{Title: "Example"}}
+
+	out, err := teseo.NewHead(og, customMetaTags{}).ToGoHTML()
+	if err != nil {
+		t.Fatalf("failed to render the head: %v", err)
+	}
+
+	custom := strings.Index(string(out), `<meta name="theme-color" content="#ffffff" />`)
+	if custom == -1 {
+		t.Fatalf("expected the custom meta tag in the output\n%s", out)
+	}
+	if title := strings.Index(string(out), `property="og:title"`); title == -1 || title > custom {
+		t.Errorf("expected the custom meta tag after the Open Graph tags\n%s", out)
+	}
+}
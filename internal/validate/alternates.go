@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/indaco/teseo"
+)
+
+// Alternate is a language alternate of a page, e.g. a links.Alternate.
+type Alternate struct {
+	Hreflang string // BCP 47 language tag, e.g. "en" or "de-AT", or "x-default"
+	Href     string // URL of the page in that language
+}
+
+// Alternates checks the language alternates of the page at url, stored in the list property name:
+// every alternate requires an absolute href and a unique hreflang, a BCP 47 language tag or "x-default".
+// When there are alternates, it records a warning when none of them points to url, unless url is empty,
+// and when none of them is "x-default".
+func (v *Validator) Alternates(name, url string, alternates []Alternate) {
+	seen := map[string]bool{}
+	self, hasDefault := false, false
+	for i, alt := range alternates {
+		av := v.Index(name, i)
+		av.Required("href", alt.Href != "")
+		av.URL("href", alt.Href)
+		av.Required("hreflang", alt.Hreflang != "")
+		if alt.Hreflang == "x-default" {
+			hasDefault = true
+		} else {
+			av.LanguageTag("hreflang", alt.Hreflang)
+		}
+
+		lang := strings.ToLower(alt.Hreflang)
+		if seen[lang] {
+			av.Add(teseo.SeverityError, "hreflang", "unique", fmt.Sprintf("duplicate hreflang %q", alt.Hreflang))
+		}
+		seen[lang] = true
+		self = self || (url != "" && alt.Href == url)
+	}
+
+	if len(alternates) == 0 {
+		return
+	}
+	if url != "" && !self {
+		v.Add(teseo.SeverityWarning, name, "self", "the language alternates do not reference the URL of the page itself")
+	}
+	if !hasDefault {
+		v.Add(teseo.SeverityWarning, name, "xDefault", `missing "x-default" language alternate`)
+	}
+}
+
+// Reciprocal checks that the language alternates of the page at url, stored in the list property name,
+// are reciprocal: every alternate pointing to another page must be a page of cluster, which holds the
+// alternates of the pages by URL, and must reference url back. An alternate which is not a page of the
+// cluster is recorded with the severity missing.
+// For more details see: https://developers.google.com/search/docs/specialty/international/localized-versions#all-method-guidelines
+func (v *Validator) Reciprocal(name, url string, alternates []Alternate, cluster map[string][]Alternate, missing teseo.Severity) {
+	for i, alt := range alternates {
+		if alt.Href == "" || alt.Href == url {
+			continue
+		}
+		av := v.Index(name, i)
+		target, ok := cluster[alt.Href]
+		switch {
+		case !ok:
+			av.Add(missing, "href", "cluster", fmt.Sprintf("alternate %q (%s) is not a page of the cluster", alt.Href, alt.Hreflang))
+		case !references(target, url):
+			av.Add(teseo.SeverityError, "href", "reciprocal", fmt.Sprintf("alternate %q (%s) does not reference %q back", alt.Href, alt.Hreflang, url))
+		}
+	}
+}
+
+// references reports whether one of the language alternates points to href.
+func references(alternates []Alternate, href string) bool {
+	for _, alt := range alternates {
+		if alt.Href == href {
+			return true
+		}
+	}
+	return false
+}
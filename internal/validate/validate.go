@@ -0,0 +1,173 @@
+// Package validate provides the helpers shared by the Validate methods of the teseo entities.
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indaco/teseo"
+)
+
+// dateLayouts lists the ISO 8601 layouts accepted for date and date-time properties.
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// languageTag matches the BCP 47 language tags: language, extended languages, script, region and variants.
+var languageTag = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z]{3}){0,3}(-[a-zA-Z]{4})?(-([a-zA-Z]{2}|[0-9]{3}))?(-([a-zA-Z0-9]{5,8}|[0-9][a-zA-Z0-9]{3}))*$`)
+
+// Validator collects the issues of an entity and of its nested objects.
+type Validator struct {
+	rule   string
+	field  string
+	issues *[]teseo.Issue
+}
+
+// New returns a Validator for the entity, e.g. "schemaorg.Product".
+func New(entity string) *Validator {
+	return &Validator{rule: entity, issues: &[]teseo.Issue{}}
+}
+
+// Child returns a Validator for the nested object stored in the property name.
+func (v *Validator) Child(name string) *Validator {
+	return &Validator{rule: v.rule + "." + name, field: v.path(name), issues: v.issues}
+}
+
+// Index returns a Validator for the i-th nested object stored in the list property name.
+func (v *Validator) Index(name string, i int) *Validator {
+	return &Validator{rule: v.rule + "." + name, field: v.path(fmt.Sprintf("%s[%d]", name, i)), issues: v.issues}
+}
+
+// Issues returns the collected issues.
+func (v *Validator) Issues() []teseo.Issue {
+	return *v.issues
+}
+
+// Add records an issue for the property name. check completes the rule id, e.g. "required".
+func (v *Validator) Add(severity teseo.Severity, name, check, message string) {
+	v.addAt(severity, name, name, check, message)
+}
+
+// AddIndex records an issue for the i-th element of the list property name.
+func (v *Validator) AddIndex(severity teseo.Severity, name string, i int, check, message string) {
+	v.addAt(severity, name, fmt.Sprintf("%s[%d]", name, i), check, message)
+}
+
+// addAt records an issue whose field differs from the property name, e.g. an element of a list.
+func (v *Validator) addAt(severity teseo.Severity, name, field, check, message string) {
+	*v.issues = append(*v.issues, teseo.Issue{
+		Severity: severity,
+		Rule:     v.rule + "." + name + "." + check,
+		Field:    v.path(field),
+		Message:  message,
+	})
+}
+
+// Required records an error when the property name is not present.
+func (v *Validator) Required(name string, present bool) {
+	if !present {
+		v.Add(teseo.SeverityError, name, "required", fmt.Sprintf("missing required property %q", v.path(name)))
+	}
+}
+
+// Recommended records a warning when the property name is not present.
+func (v *Validator) Recommended(name string, present bool) {
+	if !present {
+		v.Add(teseo.SeverityWarning, name, "recommended", fmt.Sprintf("missing recommended property %q", v.path(name)))
+	}
+}
+
+// URL records an error when the non-empty value is not an absolute http(s) URL.
+func (v *Validator) URL(name, value string) {
+	if value != "" && !IsURL(value) {
+		v.Add(teseo.SeverityError, name, "url", fmt.Sprintf("property %q is not an absolute URL: %q", v.path(name), value))
+	}
+}
+
+// URLs records an error for each non-empty value of the list property name which is not an absolute http(s) URL.
+func (v *Validator) URLs(name string, values []string) {
+	for i, value := range values {
+		if value != "" && !IsURL(value) {
+			v.addAt(teseo.SeverityError, name, fmt.Sprintf("%s[%d]", name, i), "url",
+				fmt.Sprintf("property %q is not an absolute URL: %q", v.path(fmt.Sprintf("%s[%d]", name, i)), value))
+		}
+	}
+}
+
+// Integer records an error when the non-empty value is not a non-negative integer, e.g. a duration in seconds.
+func (v *Validator) Integer(name, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+		v.Add(teseo.SeverityError, name, "integer", fmt.Sprintf("property %q is not a non-negative integer: %q", v.path(name), value))
+	}
+}
+
+// Date records an error when the non-empty value is not an ISO 8601 date or date-time.
+func (v *Validator) Date(name, value string) {
+	if value != "" && !IsDate(value) {
+		v.Add(teseo.SeverityError, name, "date", fmt.Sprintf("property %q is not an ISO 8601 date: %q", v.path(name), value))
+	}
+}
+
+// LanguageTag records an error when the non-empty value is not a BCP 47 language tag, e.g. "en" or "en-GB".
+func (v *Validator) LanguageTag(name, value string) {
+	if value != "" && !IsLanguageTag(value) {
+		v.Add(teseo.SeverityError, name, "language", fmt.Sprintf("property %q is not a BCP 47 language tag: %q", v.path(name), value))
+	}
+}
+
+// path returns the field path of the property name.
+func (v *Validator) path(name string) string {
+	if v.field == "" {
+		return name
+	}
+	return v.field + "." + name
+}
+
+// IsURL reports whether s is an absolute http or https URL.
+func IsURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// IsLanguageTag reports whether s is a BCP 47 language tag, e.g. "en", "en-GB" or "zh-Hant-TW".
+// "UK" is rejected as region, the ISO 3166-1 code of the United Kingdom is "GB".
+func IsLanguageTag(s string) bool {
+	if !languageTag.MatchString(s) {
+		return false
+	}
+	for _, subtag := range strings.Split(s, "-")[1:] {
+		if strings.EqualFold(subtag, "uk") {
+			return false
+		}
+	}
+	return true
+}
+
+// IsDate reports whether s is an ISO 8601 date or date-time.
+func IsDate(s string) bool {
+	for _, layout := range dateLayouts {
+		if _, err := time.Parse(layout, s); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// NotEmpty reports whether s contains something other than white space.
+func NotEmpty(s string) bool {
+	return strings.TrimSpace(s) != ""
+}
@@ -0,0 +1,104 @@
+package teseo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"sync/atomic"
+
+	"github.com/a-h/templ"
+)
+
+// IDStrategy computes the id attribute of the `<script>` element rendering a JSON-LD entity.
+// prefix identifies the entity type (e.g. "product") and data is the marshaled JSON-LD.
+// Returning an empty string omits the id attribute.
+type IDStrategy func(prefix string, data []byte) string
+
+// HashID is the default IDStrategy. It derives the id from a hash of the JSON-LD content,
+// e.g. "product-3f2a9c1b7d4e8f60", so the same entity always renders the same bytes.
+func HashID(prefix string, data []byte) string {
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("%s-%s", prefix, hex.EncodeToString(sum[:8]))
+}
+
+// NoID is an IDStrategy omitting the id attribute.
+func NoID(prefix string, data []byte) string {
+	return ""
+}
+
+// RandomID is an IDStrategy generating a random id on every render, e.g. "product-aZ3...".
+func RandomID(prefix string, data []byte) string {
+	return fmt.Sprintf("%s-%s", prefix, GenerateUniqueKey())
+}
+
+var idStrategy atomic.Pointer[IDStrategy]
+
+// SetIDStrategy sets the IDStrategy used by every JSON-LD entity. Pass nil to restore HashID.
+//
+// Example usage:
+//
+//	// Omit the id attribute
+//	teseo.SetIDStrategy(teseo.NoID)
+//
+//	// Supply your own ids
+//	teseo.SetIDStrategy(func(prefix string, data []byte) string {
+//		return "ld-" + prefix
+//	})
+func SetIDStrategy(s IDStrategy) {
+	if s == nil {
+		idStrategy.Store(nil)
+		return
+	}
+	idStrategy.Store(&s)
+}
+
+// currentIDStrategy returns the IDStrategy set with SetIDStrategy, HashID by default.
+func currentIDStrategy() IDStrategy {
+	if s := idStrategy.Load(); s != nil {
+		return *s
+	}
+	return HashID
+}
+
+// JsonLdScript returns a `templ.Component` rendering v as an `application/ld+json` script.
+// The id attribute is computed by the current IDStrategy from prefix and the marshaled v.
+func JsonLdScript(prefix string, v any) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s JSON-LD: %w", prefix, err)
+		}
+		id := currentIDStrategy()(prefix, data)
+		return templ.JSONScript(id, json.RawMessage(data)).WithType("application/ld+json").Render(ctx, w)
+	})
+}
+
+// WriteJsonLd writes v as an `application/ld+json` script to w without going through templ.
+// The output is the same as the one of JsonLdScript, without the CSP nonce of the templ context.
+func WriteJsonLd(w io.Writer, prefix string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s JSON-LD: %w", prefix, err)
+	}
+
+	if _, err := io.WriteString(w, "<script"); err != nil {
+		return err
+	}
+	if id := currentIDStrategy()(prefix, data); id != "" {
+		if _, err := fmt.Fprintf(w, ` id="%s"`, html.EscapeString(id)); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(w, ` type="application/ld+json">`); err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(json.RawMessage(data)); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "</script>")
+	return err
+}
@@ -0,0 +1,43 @@
+package teseo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func renderString(t *testing.T, c templ.Component) string {
+	t.Helper()
+	html, err := templ.ToGoHTML(context.Background(), c)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	return string(html)
+}
+
+// TestJsonLdScriptIDStrategies tests the deterministic default ids and the alternative strategies
+func TestJsonLdScriptIDStrategies(t *testing.T) {
+	entity := map[string]string{"@type": "Thing", "name": "Example"}
+
+	first := renderString(t, JsonLdScript("thing", entity))
+	second := renderString(t, JsonLdScript("thing", entity))
+	if first != second {
+		t.Errorf("expected byte-stable output.\nFirst:\n%s\nSecond:\n%s", first, second)
+	}
+	if !strings.HasPrefix(first, `<script id="thing-`) {
+		t.Errorf("expected a hash based id, got %s", first)
+	}
+
+	SetIDStrategy(NoID)
+	defer SetIDStrategy(nil)
+	if got := renderString(t, JsonLdScript("thing", entity)); strings.Contains(got, " id=") {
+		t.Errorf("expected no id attribute, got %s", got)
+	}
+
+	SetIDStrategy(func(prefix string, data []byte) string { return "custom-" + prefix })
+	if got := renderString(t, JsonLdScript("thing", entity)); !strings.Contains(got, `id="custom-thing"`) {
+		t.Errorf("expected custom id, got %s", got)
+	}
+}
@@ -0,0 +1,283 @@
+// Package links renders the `<link>` tags of a page declaring its canonical URL, its language
+// alternates (hreflang) and its alternate formats, e.g. an RSS feed, and the matching HTTP `Link` header.
+package links
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+)
+
+// XDefault is the hreflang of the alternate shown to users whose language matches no other alternate.
+const XDefault = "x-default"
+
+// Link represents a single `<link>` tag.
+type Link struct {
+	Rel      string // Relation, e.g. "canonical" or "alternate"
+	Href     string // URL of the linked resource
+	Hreflang string // Language of the linked resource, e.g. "en-GB" or "x-default"
+	Type     string // Media type of the linked resource, e.g. "application/rss+xml"
+	Media    string // Media query of the linked resource, e.g. "only screen and (max-width: 640px)"
+	Title    string // Title of the linked resource
+}
+
+// Alternate is a language alternate of a page.
+type Alternate struct {
+	Hreflang string // BCP 47 language tag, e.g. "en" or "de-AT", or XDefault
+	Href     string // URL of the page in that language
+}
+
+// Page declares the canonical URL and the alternates of a page.
+// For more details see: https://developers.google.com/search/docs/specialty/international/localized-versions
+//
+// Example usage:
+//
+//	page := &links.Page{
+//		Canonical: "https://www.example.com/en/about",
+//		Alternates: []links.Alternate{
+//			{Hreflang: "en", Href: "https://www.example.com/en/about"},
+//			{Hreflang: "de", Href: "https://www.example.com/de/about"},
+//			{Hreflang: links.XDefault, Href: "https://www.example.com/about"},
+//		},
+//		Formats: []links.Link{
+//			{Rel: "alternate", Type: "application/rss+xml", Href: "https://www.example.com/feed.xml", Title: "Blog"},
+//		},
+//	}
+//
+// // Rendering the HTML link tags using templ:
+//
+//	templ Page() {
+//		@page.ToLinkTags()
+//	}
+//
+// // Rendering the HTML link tags as `template.HTML` value:
+//
+//	linkTagsHtml, err := page.ToGoHTMLLinkTags()
+//
+// // Setting the HTTP Link header, e.g. for PDF documents:
+//
+//	page.SetHeader(w.Header())
+//
+// Expected output:
+//
+//	<link rel="canonical" href="https://www.example.com/en/about" />
+//	<link rel="alternate" hreflang="en" href="https://www.example.com/en/about" />
+//	<link rel="alternate" hreflang="de" href="https://www.example.com/de/about" />
+//	<link rel="alternate" hreflang="x-default" href="https://www.example.com/about" />
+//	<link rel="alternate" type="application/rss+xml" title="Blog" href="https://www.example.com/feed.xml" />
+type Page struct {
+	Canonical  string      // Canonical URL of the page
+	Alternates []Alternate // Language alternates, including the page itself and XDefault
+	Formats    []Link      // Other links, e.g. alternate media or formats (RSS, AMP, mobile)
+}
+
+// Links returns the links of the page in rendering order: canonical, language alternates and formats.
+// Links without href are skipped.
+func (p *Page) Links() []Link {
+	var result []Link
+	if p.Canonical != "" {
+		result = append(result, Link{Rel: "canonical", Href: p.Canonical})
+	}
+	for _, alt := range p.Alternates {
+		if alt.Href != "" {
+			result = append(result, Link{Rel: "alternate", Hreflang: alt.Hreflang, Href: alt.Href})
+		}
+	}
+	for _, link := range p.Formats {
+		if link.Href != "" {
+			result = append(result, link)
+		}
+	}
+	return result
+}
+
+// ToLinkTags generates the HTML link tags of the Page as templ.Component.
+func (p *Page) ToLinkTags() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return p.Render(w)
+	})
+}
+
+// ToGoHTMLLinkTags generates the HTML link tags of the Page as `template.HTML` value for Go's `html/template`.
+func (p *Page) ToGoHTMLLinkTags() (template.HTML, error) {
+	return teseo.RenderGoHTML("links.Page", p.ToLinkTags())
+}
+
+// Render writes the HTML link tags of the Page to w, without going through templ.
+func (p *Page) Render(w io.Writer) error {
+	for _, link := range p.Links() {
+		if err := WriteLink(w, link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Header returns the value of the HTTP Link header declaring the links of the Page, e.g.
+// `<https://www.example.com/en/about>; rel="canonical", <https://www.example.com/de/about>; rel="alternate"; hreflang="de"`.
+// Following RFC 8288, the characters not allowed in a URI are percent-encoded and the parameters are
+// quoted strings; a non-ASCII title is declared as UTF-8 encoded `title*` too (RFC 8187).
+func (p *Page) Header() string {
+	var values []string
+	for _, link := range p.Links() {
+		var sb strings.Builder
+		sb.WriteString("<" + headerURL(link.Href) + ">")
+		for _, param := range [][2]string{{"rel", link.Rel}, {"hreflang", link.Hreflang}, {"type", link.Type}, {"media", link.Media}, {"title", link.Title}} {
+			if param[1] != "" {
+				sb.WriteString("; " + param[0] + "=" + quotedString(param[1]))
+			}
+		}
+		if !isASCII(link.Title) {
+			sb.WriteString("; title*=UTF-8''" + extValue(link.Title))
+		}
+		values = append(values, sb.String())
+	}
+	return strings.Join(values, ", ")
+}
+
+// SetHeader sets the HTTP Link header of h, e.g. to declare the canonical URL of non-HTML resources.
+func (p *Page) SetHeader(h http.Header) {
+	if value := p.Header(); value != "" {
+		h.Set("Link", value)
+	}
+}
+
+// Validate checks the canonical URL and the language alternates of the Page.
+func (p *Page) Validate() []teseo.Issue {
+	v := validate.New("links.Page")
+	v.URL("canonical", p.Canonical)
+
+	v.Alternates("alternates", p.Canonical, p.alternates())
+
+	for i, link := range p.Formats {
+		lv := v.Index("formats", i)
+		lv.Required("rel", link.Rel != "")
+		lv.Required("href", link.Href != "")
+		lv.URL("href", link.Href)
+		lv.LanguageTag("hreflang", link.Hreflang)
+	}
+
+	return v.Issues()
+}
+
+// ValidateCluster checks that the language alternates of pages are reciprocal: every page referenced
+// as an alternate must be in the cluster and must reference back the pages referencing it.
+// For more details see: https://developers.google.com/search/docs/specialty/international/localized-versions#all-method-guidelines
+func ValidateCluster(pages ...*Page) []teseo.Issue {
+	v := validate.New("links.Cluster")
+
+	cluster := map[string][]validate.Alternate{}
+	for _, p := range pages {
+		if p.Canonical != "" {
+			cluster[p.Canonical] = p.alternates()
+		}
+	}
+
+	for i, p := range pages {
+		v.Index("pages", i).Reciprocal("alternates", p.Canonical, p.alternates(), cluster, teseo.SeverityError)
+	}
+
+	return v.Issues()
+}
+
+// alternates returns the language alternates of the Page as checked by the validate package.
+func (p *Page) alternates() []validate.Alternate {
+	result := make([]validate.Alternate, len(p.Alternates))
+	for i, alt := range p.Alternates {
+		result[i] = validate.Alternate(alt)
+	}
+	return result
+}
+
+// WriteLink writes a single HTML link tag to the provided writer.
+func WriteLink(w io.Writer, link Link) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<link rel="%s"`, html.EscapeString(link.Rel))
+	for _, attr := range [][2]string{{"hreflang", link.Hreflang}, {"type", link.Type}, {"media", link.Media}, {"title", link.Title}, {"href", link.Href}} {
+		if attr[1] != "" {
+			fmt.Fprintf(&sb, ` %s="%s"`, attr[0], html.EscapeString(attr[1]))
+		}
+	}
+	sb.WriteString(" />")
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return fmt.Errorf("failed to write %s link tag: %w", link.Rel, err)
+	}
+	return nil
+}
+
+// headerURL percent-encodes the bytes of href not allowed in a URI reference, e.g. spaces,
+// `<`, `>` and non-ASCII characters, so href can be written between `<` and `>`.
+func headerURL(href string) string {
+	var sb strings.Builder
+	for i := 0; i < len(href); i++ {
+		c := href[i]
+		if isURIChar(c) {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+// isURIChar reports whether c is an unreserved or reserved URI character (RFC 3986), or `%`.
+func isURIChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("-._~:/?#[]@!$&'()*+,;=%", c) >= 0
+}
+
+// quotedString returns s as HTTP quoted-string (RFC 9110): `"` and `\` are escaped with a backslash
+// and control characters, not allowed in header values, are dropped. Other characters are kept as is.
+func quotedString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c < 0x20 && c != '\t', c == 0x7f:
+			// Dropped
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// extValue returns s as UTF-8 encoded ext-value (RFC 8187), without the charset prefix.
+func extValue(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte("!#$&+-.^_`|~", c) >= 0 {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+// isASCII reports whether s holds ASCII characters only.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
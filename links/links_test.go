@@ -0,0 +1,110 @@
+package links
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+)
+
+func aboutPage(lang string, alternates ...string) *Page {
+	page := &Page{Canonical: "https://www.example.com/" + lang + "/about"}
+	for _, alt := range alternates {
+		href := "https://www.example.com/" + alt + "/about"
+		if alt == XDefault {
+			href = "https://www.example.com/about"
+		}
+		page.Alternates = append(page.Alternates, Alternate{Hreflang: alt, Href: href})
+	}
+	return page
+}
+
+// TestRender tests the link tags, the HTTP Link header and the Head integration
+func TestRender(t *testing.T) {
+	page := aboutPage("en", "en", "de", XDefault)
+	page.Formats = []Link{{Rel: "alternate", Type: "application/rss+xml", Title: `"News" & more`, Href: "https://www.example.com/feed.xml"}}
+
+	html, err := page.ToGoHTMLLinkTags()
+	if err != nil {
+		t.Fatalf("ToGoHTMLLinkTags failed: %v", err)
+	}
+	expected := `<link rel="canonical" href="https://www.example.com/en/about" />` +
+		`<link rel="alternate" hreflang="en" href="https://www.example.com/en/about" />` +
+		`<link rel="alternate" hreflang="de" href="https://www.example.com/de/about" />` +
+		`<link rel="alternate" hreflang="x-default" href="https://www.example.com/about" />` +
+		`<link rel="alternate" type="application/rss+xml" title="&#34;News&#34; &amp; more" href="https://www.example.com/feed.xml" />`
+	if string(html) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, html)
+	}
+
+	h := http.Header{}
+	page.SetHeader(h)
+	if !strings.HasPrefix(h.Get("Link"), `<https://www.example.com/en/about>; rel="canonical", <https://www.example.com/en/about>; rel="alternate"; hreflang="en"`) {
+		t.Errorf("unexpected Link header %s", h.Get("Link"))
+	}
+
+	head, err := teseo.NewHead(&opengraph.WebSite{OpenGraphObject: opengraph.OpenGraphObject{Title: "About"}}, page).ToGoHTML()
+	if err != nil {
+		t.Fatalf("Head failed: %v", err)
+	}
+	if !strings.HasPrefix(string(head), `<link rel="canonical"`) || !strings.Contains(string(head), "og:title") {
+		t.Errorf("expected the link tags before the meta tags, got %s", head)
+	}
+}
+
+// TestHeaderEncoding tests the quoted-string parameters and the percent-encoded targets of the Link header
+func TestHeaderEncoding(t *testing.T) {
+	page := &Page{Formats: []Link{
+		{Rel: "alternate", Type: "application/rss+xml", Title: `Café "Crème" \ news`, Href: "https://www.example.com/feed?q=<a b>&l=ü"},
+		{Rel: "alternate", Title: "line\r\nbreak", Href: "https://www.example.com/a%20b"},
+	}}
+
+	expected := `<https://www.example.com/feed?q=%3Ca%20b%3E&l=%C3%BC>; rel="alternate"; type="application/rss+xml"; ` +
+		`title="Café \"Crème\" \\ news"; title*=UTF-8''Caf%C3%A9%20%22Cr%C3%A8me%22%20%5C%20news, ` +
+		`<https://www.example.com/a%20b>; rel="alternate"; title="linebreak"`
+	if got := page.Header(); got != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, got)
+	}
+}
+
+// TestValidate tests the hreflang codes and the cluster reciprocity
+func TestValidate(t *testing.T) {
+	page := &Page{
+		Canonical: "https://www.example.com/en/about",
+		Alternates: []Alternate{
+			{Hreflang: "en-UK", Href: "https://www.example.com/en/about"},
+			{Hreflang: "de_DE", Href: "https://www.example.com/de/about"},
+			{Hreflang: "zh-Hant-TW", Href: "https://www.example.com/zh/about"},
+			{Hreflang: "ZH-hant-tw", Href: "https://www.example.com/tw/about"},
+		},
+	}
+	rules := map[string]int{}
+	for _, issue := range page.Validate() {
+		rules[issue.Rule]++
+	}
+	expected := map[string]int{
+		"links.Page.alternates.hreflang.language": 2,
+		"links.Page.alternates.hreflang.unique":   1,
+		"links.Page.alternates.xDefault":          1,
+	}
+	for rule, n := range expected {
+		if rules[rule] != n {
+			t.Errorf("expected %d %s issues, got %v", n, rule, rules)
+		}
+	}
+
+	en := aboutPage("en", "en", "de", "fr")
+	de := aboutPage("de", "en", "de")
+	issues := ValidateCluster(en, de)
+	if len(issues) != 1 || issues[0].Rule != "links.Cluster.pages.alternates.href.cluster" {
+		t.Errorf("expected the missing fr page, got %v", issues)
+	}
+
+	fr := aboutPage("fr", "fr")
+	issues = ValidateCluster(en, de, fr)
+	if len(issues) != 1 || issues[0].Rule != "links.Cluster.pages.alternates.href.reciprocal" || issues[0].Field != "pages[0].alternates[2].href" {
+		t.Errorf("expected the fr page not referencing the en page, got %v", issues)
+	}
+}
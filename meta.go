@@ -0,0 +1,133 @@
+package teseo
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+
+	"github.com/a-h/templ"
+)
+
+// MetaAttribute is the attribute of a meta tag holding its key, e.g. `property` or `name`.
+type MetaAttribute string
+
+func (a MetaAttribute) String() string {
+	return string(a)
+}
+
+const (
+	// MetaProperty is the attribute used by RDFa vocabularies, e.g. Open Graph (`og:title`, `article:tag`).
+	MetaProperty MetaAttribute = "property"
+	// MetaName is the attribute used by standard metadata names (e.g. `description`, `robots`) and Twitter Cards.
+	MetaName MetaAttribute = "name"
+	// MetaItemProp is the attribute used by microdata properties.
+	MetaItemProp MetaAttribute = "itemprop"
+	// MetaHTTPEquiv is the attribute used by pragma directives, e.g. `content-security-policy` or `refresh`.
+	MetaHTTPEquiv MetaAttribute = "http-equiv"
+)
+
+// MetaTag represents a single HTML meta tag, e.g. `<meta name="twitter:card" content="summary"/>`.
+type MetaTag struct {
+	Attribute MetaAttribute // Attribute holding the key; MetaProperty when empty
+	Key       string        // Value of the attribute, e.g. "og:title", "description" or "refresh"
+	Content   string        // Value of the content attribute
+}
+
+// MetaTagger is the interface implemented by types exposing their HTML meta tags, e.g. every
+// Open Graph type and the Twitter Card. Tags returns the tags in rendering order, multi-valued
+// properties (e.g. several `article:tag`) included, and never returns tags with an empty content.
+type MetaTagger interface {
+	Tags() []MetaTag
+}
+
+// MetaTags returns a `templ.Component` rendering the meta tags of every item in order.
+//
+// Example usage:
+//
+//	// Keep only the Open Graph properties of an article
+//	var tags []teseo.MetaTag
+//	for _, tag := range article.Tags() {
+//		if strings.HasPrefix(tag.Key, "og:") {
+//			tags = append(tags, tag)
+//		}
+//	}
+//
+//	templ Page() {
+//		@teseo.MetaTags(teseo.Tags(tags), card)
+//	}
+func MetaTags(items ...MetaTagger) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return WriteMetaTags(w, items...)
+	})
+}
+
+// WriteMetaTags writes the meta tags of every item in order to w without going through templ.
+func WriteMetaTags(w io.Writer, items ...MetaTagger) error {
+	for _, item := range items {
+		for _, tag := range item.Tags() {
+			if err := WriteMeta(w, tag); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Tags is a list of meta tags implementing MetaTagger, e.g. to render tags filtered or merged by hand.
+type Tags []MetaTag
+
+// Tags returns the tags of the list.
+func (t Tags) Tags() []MetaTag {
+	return t
+}
+
+// attribute returns the attribute of the tag, defaulting to MetaProperty when not specified.
+func (t MetaTag) attribute() MetaAttribute {
+	if t.Attribute == "" {
+		return MetaProperty
+	}
+	return t.Attribute
+}
+
+// WriteMeta writes a single HTML meta tag to the provided writer using the attribute of the tag.
+// Tags with an empty content are skipped.
+func WriteMeta(w io.Writer, tag MetaTag) error {
+	if tag.Content == "" {
+		return nil
+	}
+
+	tag.Attribute = tag.attribute()
+	switch tag.Attribute {
+	case MetaProperty, MetaName, MetaItemProp, MetaHTTPEquiv:
+	default:
+		return fmt.Errorf("unsupported meta tag attribute %q", tag.Attribute)
+	}
+
+	if c, ok := w.(metaTagCollector); ok {
+		c.collectMetaTag(tag)
+		return nil
+	}
+	_, err := fmt.Fprintf(w, `<meta %s="%s" content="%s" />`, tag.Attribute, html.EscapeString(tag.Key), html.EscapeString(tag.Content))
+	if err != nil {
+		return fmt.Errorf("failed to write %s meta tag: %w", tag.Key, err)
+	}
+	return nil
+}
+
+// WriteMetaTag writes a single HTML meta tag with a `property` attribute to the provided writer.
+// Use WriteMeta to write tags with a different attribute, e.g. `name` or `http-equiv`.
+func WriteMetaTag(w io.Writer, property, content string) error {
+	return WriteMeta(w, MetaTag{Attribute: MetaProperty, Key: property, Content: content})
+}
+
+// WriteTitle writes the `<title>` element of a page to the provided writer. An empty title is skipped.
+func WriteTitle(w io.Writer, title string) error {
+	if title == "" {
+		return nil
+	}
+	if _, err := fmt.Fprintf(w, "<title>%s</title>", html.EscapeString(title)); err != nil {
+		return fmt.Errorf("failed to write title: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Package meta renders the standard HTML head metadata of a page: the `<title>` element and the
+// `description`, `keywords`, `author`, `robots`, `viewport`, `theme-color` and `color-scheme` meta tags.
+package meta
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+)
+
+const (
+	// MaxTitleLength is the number of characters of a title above which search engines usually truncate it.
+	MaxTitleLength = 60
+	// MaxDescriptionLength is the number of characters of a description above which search engines usually truncate it.
+	MaxDescriptionLength = 160
+)
+
+// Page contains the standard HTML head metadata of a page.
+// For more details about the meaning of the properties see: https://html.spec.whatwg.org/multipage/semantics.html#standard-metadata-names
+//
+// Example usage:
+//
+//	page := &meta.Page{
+//		Title:       "About",
+//		Description: "Learn more about Example.",
+//		Keywords:    []string{"example", "about"},
+//		Author:      "Jane Doe",
+//		Robots:      "index, follow",
+//		Viewport:    "width=device-width, initial-scale=1",
+//		ThemeColor:  "#ffffff",
+//		ColorScheme: "light dark",
+//	}
+//
+// // Rendering the title and the HTML meta tags using templ:
+//
+//	templ Page() {
+//		@page.ToMetaTags()
+//	}
+//
+// // Rendering the title and the HTML meta tags as `template.HTML` value:
+//
+//	metaTagsHtml, err := page.ToGoHTMLMetaTags()
+//
+// Expected output:
+//
+//	<title>About</title>
+//	<meta name="description" content="Learn more about Example." />
+//	<meta name="keywords" content="example, about" />
+//	<meta name="author" content="Jane Doe" />
+//	<meta name="robots" content="index, follow" />
+//	<meta name="viewport" content="width=device-width, initial-scale=1" />
+//	<meta name="theme-color" content="#ffffff" />
+//	<meta name="color-scheme" content="light dark" />
+type Page struct {
+	Title       string   // Title of the page, rendered as `<title>` element
+	Description string   // description, a short summary of the page
+	Keywords    []string // keywords, rendered as a comma separated list
+	Author      string   // author, the name of the author of the page
+	Robots      string   // robots, the crawling and indexing directives, e.g. "noindex, nofollow"
+	Viewport    string   // viewport, e.g. "width=device-width, initial-scale=1"
+	ThemeColor  string   // theme-color, the color used by the browser UI, e.g. "#ffffff"
+	ColorScheme string   // color-scheme, the color schemes supported by the page, e.g. "light dark"
+
+	mergedTitle teseo.MergedTitle
+}
+
+// NewPage initializes a Page with the provided title and description.
+func NewPage(title, description string) *Page {
+	return &Page{Title: title, Description: description}
+}
+
+// PageTitle returns the title of the page. It makes Page implement teseo.Titler.
+func (p *Page) PageTitle() string {
+	return p.Title
+}
+
+// Tags returns the meta tags of the Page, written with the `name` attribute.
+// The title is not a meta tag, see PageTitle.
+func (p *Page) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		name("description", p.Description),
+		name("keywords", keywords(p.Keywords)),
+		name("author", p.Author),
+		name("robots", p.Robots),
+		name("viewport", p.Viewport),
+		name("theme-color", p.ThemeColor),
+		name("color-scheme", p.ColorScheme),
+	}
+
+	result := tags[:0]
+	for _, tag := range tags {
+		if tag.Content != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// ToMetaTags generates the title and the HTML meta tags of the Page as templ.Component.
+func (p *Page) ToMetaTags() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return p.Render(w)
+	})
+}
+
+// ToGoHTMLMetaTags generates the title and the HTML meta tags of the Page as `template.HTML` value for Go's `html/template`.
+func (p *Page) ToGoHTMLMetaTags() (template.HTML, error) {
+	return teseo.RenderGoHTML("meta.Page", p.ToMetaTags())
+}
+
+// Render writes the title and the HTML meta tags of the Page to w, without going through templ.
+func (p *Page) Render(w io.Writer) error {
+	if err := teseo.WriteTitle(w, p.PageTitle()); err != nil {
+		return err
+	}
+	return teseo.WriteMetaTags(w, p)
+}
+
+// MergeSite formats the title with the title template of the Site, e.g. "About | Example";
+// an empty title falls back to the site name. Merging the same Site again has no further effect,
+// see teseo.Site.MergeTitle. Use teseo.Site.Merged to leave a shared Page unchanged.
+func (p *Page) MergeSite(site *teseo.Site) {
+	site.MergeTitle(&p.Title, &p.mergedTitle)
+}
+
+// Validate checks the title and the description of the Page against the search engines guidelines.
+// For more details see: https://developers.google.com/search/docs/appearance/title-link
+func (p *Page) Validate() []teseo.Issue {
+	v := validate.New("meta.Page")
+	v.Required("title", validate.NotEmpty(p.Title))
+	v.Recommended("description", validate.NotEmpty(p.Description))
+
+	if n := utf8.RuneCountInString(p.PageTitle()); n > MaxTitleLength {
+		v.Add(teseo.SeverityWarning, "title", "length", fmt.Sprintf("title is %d characters long, it may be truncated above %d", n, MaxTitleLength))
+	}
+	if n := utf8.RuneCountInString(p.Description); n > MaxDescriptionLength {
+		v.Add(teseo.SeverityWarning, "description", "length", fmt.Sprintf("description is %d characters long, it may be truncated above %d", n, MaxDescriptionLength))
+	}
+
+	return v.Issues()
+}
+
+// name returns a standard metadata meta tag, written with the `name` attribute.
+func name(key, content string) teseo.MetaTag {
+	return teseo.MetaTag{Attribute: teseo.MetaName, Key: key, Content: content}
+}
+
+// keywords joins the non-empty keywords with a comma.
+func keywords(values []string) string {
+	var result []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return strings.Join(result, ", ")
+}
@@ -0,0 +1,96 @@
+package meta
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+)
+
+// TestRender tests the title, the escaping and the order of the meta tags
+func TestRender(t *testing.T) {
+	page := &Page{
+		Title:       "Tom & Jerry",
+		Description: `The "best" cartoon`,
+		Keywords:    []string{"cartoon", " ", "cat"},
+		Robots:      "noindex",
+	}
+
+	html, err := page.ToGoHTMLMetaTags()
+	if err != nil {
+		t.Fatalf("ToGoHTMLMetaTags failed: %v", err)
+	}
+	expected := `<title>Tom &amp; Jerry</title>` +
+		`<meta name="description" content="The &#34;best&#34; cartoon" />` +
+		`<meta name="keywords" content="cartoon, cat" />` +
+		`<meta name="robots" content="noindex" />`
+	if string(html) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, html)
+	}
+}
+
+// TestHead tests that the Page plugs into Head along with the Open Graph values and the site defaults
+func TestHead(t *testing.T) {
+	site := &teseo.Site{Name: "Example", TitleTemplate: "%s | Example"}
+	page := &Page{Title: "About", Description: "About Example"}
+	og := &opengraph.WebSite{OpenGraphObject: opengraph.OpenGraphObject{Title: "About"}}
+
+	md := teseo.NewMetadata(site)
+	md.Add(page)
+	md.SetOpenGraph(og)
+	html, err := md.ToGoHTML()
+	if err != nil {
+		t.Fatalf("ToGoHTML failed: %v", err)
+	}
+	out := string(html)
+
+	if !strings.HasPrefix(out, `<title>About | Example</title><meta name="description" content="About Example" />`) {
+		t.Errorf("expected the title and the description first, got %s", out)
+	}
+	if !strings.Contains(out, `property="og:title" content="About | Example"`) {
+		t.Errorf("expected the formatted Open Graph title, got %s", out)
+	}
+	if page.Title != "About" {
+		t.Errorf("merging modified the title: %q", page.Title)
+	}
+}
+
+// TestMergeSite tests that the title is formatted once and that Merged leaves a shared Page unchanged
+func TestMergeSite(t *testing.T) {
+	site := &teseo.Site{Name: "Example", TitleTemplate: "%s | Example"}
+	other := &teseo.Site{Name: "Other", TitleTemplate: "%s - Other"}
+	shared := &Page{Title: "About"}
+
+	if got := site.Merged(shared).(*Page).PageTitle(); got != "About | Example" {
+		t.Errorf("expected formatted title, got %q", got)
+	}
+	if got := other.Merged(shared).(*Page).PageTitle(); got != "About - Other" {
+		t.Errorf("expected title formatted for the other site, got %q", got)
+	}
+	if shared.Title != "About" {
+		t.Errorf("Merged modified the shared Page: %q", shared.Title)
+	}
+
+	page := &Page{}
+	site.Merge(page)
+	site.Merge(page)
+	if page.Title != "Example" {
+		t.Errorf("expected the site name, got %q", page.Title)
+	}
+}
+
+// TestValidate tests the title and description rules
+func TestValidate(t *testing.T) {
+	page := &Page{Title: strings.Repeat("a", MaxTitleLength+1)}
+
+	rules := map[string]bool{}
+	for _, issue := range page.Validate() {
+		rules[issue.Rule] = true
+	}
+	for _, rule := range []string{"meta.Page.title.length", "meta.Page.description.recommended"} {
+		if !rules[rule] {
+			t.Errorf("expected %s, got %v", rule, rules)
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package meta
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+)
+
+// Directive is a crawling or indexing directive of the robots meta tag and of the `X-Robots-Tag` header.
+// For more details see: https://developers.google.com/search/docs/crawling-indexing/robots-meta-tag
+type Directive string
+
+const (
+	All          Directive = "all"          // No restrictions, the default behaviour
+	None         Directive = "none"         // Equivalent to noindex, nofollow
+	Index        Directive = "index"        // Show the page in search results
+	NoIndex      Directive = "noindex"      // Do not show the page in search results
+	Follow       Directive = "follow"       // Follow the links of the page
+	NoFollow     Directive = "nofollow"     // Do not follow the links of the page
+	NoArchive    Directive = "noarchive"    // Do not show a cached link in search results
+	NoCache      Directive = "nocache"      // Same as noarchive, used by Bing
+	NoSnippet    Directive = "nosnippet"    // Do not show a text snippet or video preview in search results
+	NoImageIndex Directive = "noimageindex" // Do not index the images of the page
+	NoTranslate  Directive = "notranslate"  // Do not offer a translation of the page in search results
+)
+
+// ImagePreview is the maximum size of an image preview, see MaxImagePreview.
+type ImagePreview string
+
+const (
+	ImagePreviewNone     ImagePreview = "none"     // No image preview
+	ImagePreviewStandard ImagePreview = "standard" // A default image preview
+	ImagePreviewLarge    ImagePreview = "large"    // A larger image preview, up to the width of the viewport
+)
+
+// Names of the crawlers commonly targeted by per-bot directives.
+const (
+	Googlebot     = "googlebot"
+	GooglebotNews = "googlebot-news"
+	Bingbot       = "bingbot"
+)
+
+// MaxSnippet returns the directive limiting the text snippet to n characters.
+// 0 is equivalent to NoSnippet, -1 means no limit.
+func MaxSnippet(n int) Directive {
+	return Directive("max-snippet:" + strconv.Itoa(n))
+}
+
+// MaxImagePreview returns the directive limiting the size of the image preview.
+func MaxImagePreview(size ImagePreview) Directive {
+	return Directive("max-image-preview:" + string(size))
+}
+
+// MaxVideoPreview returns the directive limiting the video preview to n seconds.
+// 0 allows a static image only, -1 means no limit.
+func MaxVideoPreview(n int) Directive {
+	return Directive("max-video-preview:" + strconv.Itoa(n))
+}
+
+// UnavailableAfter returns the directive removing the page from search results after t.
+func UnavailableAfter(t time.Time) Directive {
+	return Directive("unavailable_after: " + t.UTC().Format(time.RFC3339))
+}
+
+// Robots holds the directives of a robots meta tag, either for every crawler or for a single one.
+//
+// Example usage:
+//
+//	robots := meta.NewRobots(meta.NoIndex, meta.MaxSnippet(50))
+//	googlebot := meta.NewBotRobots(meta.Googlebot, meta.NoArchive, meta.MaxImagePreview(meta.ImagePreviewLarge))
+//
+// // Rendering the HTML meta tags using templ:
+//
+//	templ Page() {
+//		@teseo.MetaTags(robots, googlebot)
+//	}
+//
+// // Setting the `X-Robots-Tag` header, e.g. for PDF documents:
+//
+//	robots.SetHeader(w.Header())
+//
+// Expected output:
+//
+//	<meta name="robots" content="noindex, max-snippet:50" />
+//	<meta name="googlebot" content="noarchive, max-image-preview:large" />
+//
+//	X-Robots-Tag: noindex, max-snippet:50
+type Robots struct {
+	Bot        string      // Name of the crawler, e.g. Googlebot; all crawlers when empty
+	Directives []Directive // Directives, in rendering order
+}
+
+// NewRobots initializes a Robots for every crawler.
+func NewRobots(directives ...Directive) *Robots {
+	return &Robots{Directives: directives}
+}
+
+// NewBotRobots initializes a Robots for the crawler bot, e.g. Googlebot or Bingbot.
+func NewBotRobots(bot string, directives ...Directive) *Robots {
+	return &Robots{Bot: bot, Directives: directives}
+}
+
+// String returns the directives as comma separated list, e.g. "noindex, max-snippet:50".
+func (r *Robots) String() string {
+	values := make([]string, 0, len(r.Directives))
+	for _, d := range r.Directives {
+		if d != "" {
+			values = append(values, string(d))
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// Tags returns the robots meta tag, named after the crawler or `robots`.
+func (r *Robots) Tags() []teseo.MetaTag {
+	content := r.String()
+	if content == "" {
+		return nil
+	}
+	return []teseo.MetaTag{name(r.name(), content)}
+}
+
+// ToMetaTags generates the robots HTML meta tag as templ.Component.
+func (r *Robots) ToMetaTags() templ.Component {
+	return teseo.MetaTags(r)
+}
+
+// ToGoHTMLMetaTags generates the robots HTML meta tag as `template.HTML` value for Go's `html/template`.
+func (r *Robots) ToGoHTMLMetaTags() (template.HTML, error) {
+	return teseo.RenderGoHTML("meta.Robots", r.ToMetaTags())
+}
+
+// Render writes the robots HTML meta tag to w, without going through templ.
+func (r *Robots) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, r)
+}
+
+// Header returns the value of the `X-Robots-Tag` header, prefixed with the crawler name when set,
+// e.g. "googlebot: noindex, nofollow".
+func (r *Robots) Header() string {
+	content := r.String()
+	if content == "" || r.Bot == "" {
+		return content
+	}
+	return strings.ToLower(r.Bot) + ": " + content
+}
+
+// SetHeader adds the `X-Robots-Tag` header to h. The values of other Robots are kept,
+// so the directives of several crawlers can be combined.
+func (r *Robots) SetHeader(h http.Header) {
+	if value := r.Header(); value != "" {
+		h.Add("X-Robots-Tag", value)
+	}
+}
+
+// Validate checks the crawler name, the directive values and the conflicting directives.
+func (r *Robots) Validate() []teseo.Issue {
+	v := validate.New("meta.Robots")
+	if r.Bot != "" && strings.ContainsAny(r.Bot, " ,:") {
+		v.Add(teseo.SeverityError, "bot", "name", fmt.Sprintf("invalid crawler name %q", r.Bot))
+	}
+
+	seen := map[string]bool{}
+	for i, d := range r.Directives {
+		key, value, hasValue := strings.Cut(string(d), ":")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		seen[key] = true
+
+		switch key {
+		case string(All), string(None), string(Index), string(NoIndex), string(Follow), string(NoFollow),
+			string(NoArchive), string(NoCache), string(NoSnippet), string(NoImageIndex), string(NoTranslate):
+			if hasValue {
+				v.AddIndex(teseo.SeverityError, "directives", i, "value", fmt.Sprintf("directive %q takes no value", d))
+			}
+		case "max-snippet", "max-video-preview":
+			if n, err := strconv.Atoi(value); err != nil || n < -1 {
+				v.AddIndex(teseo.SeverityError, "directives", i, "value", fmt.Sprintf("directive %q expects a number of at least -1", d))
+			}
+		case "max-image-preview":
+			if size := ImagePreview(value); size != ImagePreviewNone && size != ImagePreviewStandard && size != ImagePreviewLarge {
+				v.AddIndex(teseo.SeverityError, "directives", i, "value", fmt.Sprintf("directive %q expects none, standard or large", d))
+			}
+		case "unavailable_after":
+			if !validate.IsDate(value) {
+				v.AddIndex(teseo.SeverityError, "directives", i, "value", fmt.Sprintf("directive %q expects an ISO 8601 date", d))
+			}
+		default:
+			v.AddIndex(teseo.SeverityWarning, "directives", i, "unknown", fmt.Sprintf("unknown directive %q", d))
+		}
+	}
+
+	for _, pair := range [][2]Directive{{Index, NoIndex}, {Follow, NoFollow}, {All, None}} {
+		if seen[string(pair[0])] && seen[string(pair[1])] {
+			v.Add(teseo.SeverityWarning, "directives", "conflict", fmt.Sprintf("conflicting directives %q and %q", pair[0], pair[1]))
+		}
+	}
+
+	return v.Issues()
+}
+
+// name returns the name of the meta tag.
+func (r *Robots) name() string {
+	if r.Bot == "" {
+		return "robots"
+	}
+	return strings.ToLower(r.Bot)
+}
+
+// DefaultNoIndexTypes lists the media types of the responses RobotsHeader marks as `noindex` by default.
+var DefaultNoIndexTypes = []string{
+	"application/pdf",
+	"application/msword",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/vnd.ms-excel",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"application/vnd.ms-powerpoint",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+}
+
+// RobotsHeader sets the `X-Robots-Tag` header of the responses: the directives of Robots on every
+// response, plus `noindex` on the 4xx and 5xx responses and on the responses of the NoIndexTypes.
+//
+// Example usage:
+//
+//	// Keep a staging environment and the downloadable documents out of search results
+//	headers := &meta.RobotsHeader{Robots: []*meta.Robots{meta.NewRobots(meta.NoIndex, meta.NoFollow)}}
+//	http.ListenAndServe(":8080", headers.Middleware(mux))
+type RobotsHeader struct {
+	Robots       []*Robots // Directives sent with every response
+	NoIndexTypes []string  // Media types of the responses sent with `noindex`; DefaultNoIndexTypes when nil
+	IndexErrors  bool      // Do not send `noindex` with the 4xx and 5xx responses
+}
+
+// Middleware returns a `net/http` middleware setting the `X-Robots-Tag` header of the responses of next.
+// The header is set when the status code is written, after the handler had the chance to set its own values.
+func (rh *RobotsHeader) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &robotsWriter{ResponseWriter: w, policy: rh}
+		next.ServeHTTP(rw, r)
+		rw.send(nil)
+	})
+}
+
+// apply sets the `X-Robots-Tag` header of a response with the status code and the media type.
+func (rh *RobotsHeader) apply(h http.Header, status int, contentType string) {
+	for _, robots := range rh.Robots {
+		robots.SetHeader(h)
+	}
+	if (status >= 400 && !rh.IndexErrors) || rh.noIndexType(contentType) {
+		if !hasNoIndex(h.Values("X-Robots-Tag")) {
+			h.Add("X-Robots-Tag", string(NoIndex))
+		}
+	}
+}
+
+// noIndexType reports whether contentType is one of the NoIndexTypes.
+func (rh *RobotsHeader) noIndexType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	types := rh.NoIndexTypes
+	if types == nil {
+		types = DefaultNoIndexTypes
+	}
+	for _, t := range types {
+		if strings.EqualFold(t, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNoIndex reports whether the `X-Robots-Tag` values already exclude the response for every crawler.
+func hasNoIndex(values []string) bool {
+	for _, value := range values {
+		for _, d := range strings.Split(value, ",") {
+			switch Directive(strings.ToLower(strings.TrimSpace(d))) {
+			case NoIndex, None:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// robotsWriter delays the status code until the response body starts, so the media type
+// of the response is known when the `X-Robots-Tag` header is set.
+type robotsWriter struct {
+	http.ResponseWriter
+	policy *RobotsHeader
+	status int
+	sent   bool
+}
+
+func (w *robotsWriter) WriteHeader(status int) {
+	if status >= 100 && status < 200 && status != http.StatusSwitchingProtocols {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *robotsWriter) Write(b []byte) (int, error) {
+	w.send(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends the status code and flushes the response, when supported by the underlying writer.
+func (w *robotsWriter) Flush() {
+	w.send(nil)
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer for `http.ResponseController`.
+func (w *robotsWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// send sets the `X-Robots-Tag` header and writes the status code once. body is the beginning of the
+// response body, used to detect the media type the way `net/http` does when the handler sets none.
+func (w *robotsWriter) send(body []byte) {
+	if w.sent {
+		return
+	}
+	w.sent = true
+
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	contentType := w.Header().Get("Content-Type")
+	if contentType == "" && len(body) > 0 {
+		contentType = http.DetectContentType(body)
+	}
+
+	w.policy.apply(w.Header(), status, contentType)
+	w.ResponseWriter.WriteHeader(status)
+}
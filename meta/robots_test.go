@@ -0,0 +1,121 @@
+package meta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestRobots tests the meta tags and the header values of the directives
+func TestRobots(t *testing.T) {
+	robots := NewRobots(NoIndex, MaxSnippet(50), UnavailableAfter(time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC)))
+	googlebot := NewBotRobots("Googlebot", NoArchive, MaxImagePreview(ImagePreviewLarge))
+
+	html, err := robots.ToGoHTMLMetaTags()
+	if err != nil {
+		t.Fatalf("ToGoHTMLMetaTags failed: %v", err)
+	}
+	expected := `<meta name="robots" content="noindex, max-snippet:50, unavailable_after: 2025-01-02T15:00:00Z" />`
+	if string(html) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, html)
+	}
+
+	h := http.Header{}
+	robots.SetHeader(h)
+	googlebot.SetHeader(h)
+	NewRobots().SetHeader(h)
+	expectedValues := []string{
+		"noindex, max-snippet:50, unavailable_after: 2025-01-02T15:00:00Z",
+		"googlebot: noarchive, max-image-preview:large",
+	}
+	if values := h.Values("X-Robots-Tag"); !reflect.DeepEqual(values, expectedValues) {
+		t.Errorf("expected %q, got %q", expectedValues, values)
+	}
+}
+
+// TestRobotsValidate tests the directive values and the conflicting directives
+func TestRobotsValidate(t *testing.T) {
+	robots := NewRobots(Index, NoIndex, MaxSnippet(-2), MaxImagePreview("huge"), "nofollow:1", "noodle")
+
+	rules := map[string]int{}
+	for _, issue := range robots.Validate() {
+		rules[issue.Rule+" "+issue.Field]++
+	}
+	expected := map[string]int{
+		"meta.Robots.directives.value directives[1]":   0,
+		"meta.Robots.directives.value directives[2]":   1,
+		"meta.Robots.directives.value directives[3]":   1,
+		"meta.Robots.directives.value directives[4]":   1,
+		"meta.Robots.directives.unknown directives[5]": 1,
+		"meta.Robots.directives.conflict directives":   1,
+	}
+	for key, count := range expected {
+		if rules[key] != count {
+			t.Errorf("expected %d issues %s, got %v", count, key, rules)
+		}
+	}
+}
+
+// TestRobotsHeader tests the directives set by the middleware depending on the status and the media type
+func TestRobotsHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   *RobotsHeader
+		handler  http.HandlerFunc
+		expected []string
+	}{
+		{
+			name:   "html page",
+			header: &RobotsHeader{},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("<!DOCTYPE html><html></html>"))
+			},
+			expected: nil,
+		},
+		{
+			name:   "not found",
+			header: &RobotsHeader{},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			expected: []string{"noindex"},
+		},
+		{
+			name:   "errors indexed",
+			header: &RobotsHeader{IndexErrors: true},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expected: nil,
+		},
+		{
+			name:   "sniffed pdf",
+			header: &RobotsHeader{Robots: []*Robots{NewBotRobots(Bingbot, NoArchive)}},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("%PDF-1.7\n"))
+			},
+			expected: []string{"bingbot: noarchive", "noindex"},
+		},
+		{
+			name:   "already excluded",
+			header: &RobotsHeader{Robots: []*Robots{NewRobots(None)}},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/pdf")
+				w.WriteHeader(http.StatusOK)
+			},
+			expected: []string{"none"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.header.Middleware(tt.handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			if values := rec.Result().Header.Values("X-Robots-Tag"); !reflect.DeepEqual(values, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, values)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package teseo
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestWriteMeta tests that each tag is written with its attribute and escaped content
+func TestWriteMeta(t *testing.T) {
+	tests := []struct {
+		tag      MetaTag
+		expected string
+	}{
+		{MetaTag{Key: "og:title", Content: "Example"}, `<meta property="og:title" content="Example" />`},
+		{MetaTag{Attribute: MetaName, Key: "twitter:card", Content: "summary"}, `<meta name="twitter:card" content="summary" />`},
+		{MetaTag{Attribute: MetaItemProp, Key: "name", Content: "Example"}, `<meta itemprop="name" content="Example" />`},
+		{MetaTag{Attribute: MetaHTTPEquiv, Key: "refresh", Content: "30"}, `<meta http-equiv="refresh" content="30" />`},
+		{MetaTag{Attribute: MetaName, Key: "description", Content: `"Tom" & <Jerry>`}, `<meta name="description" content="&#34;Tom&#34; &amp; &lt;Jerry&gt;" />`},
+		{MetaTag{Attribute: MetaName, Key: "description"}, ``},
+	}
+
+	for _, tt := range tests {
+		var sb strings.Builder
+		if err := WriteMeta(&sb, tt.tag); err != nil {
+			t.Fatalf("WriteMeta failed: %v", err)
+		}
+		if sb.String() != tt.expected {
+			t.Errorf("expected %s, got %s", tt.expected, sb.String())
+		}
+	}
+
+	if err := WriteMeta(&strings.Builder{}, MetaTag{Attribute: `onload="x"`, Key: "k", Content: "v"}); err == nil {
+		t.Error("expected an error for an unsupported attribute")
+	}
+}
+
+// TestMetaTags tests the generic renderer over MetaTagger values
+func TestMetaTags(t *testing.T) {
+	html := renderString(t, MetaTags(
+		Tags{{Attribute: MetaName, Key: "description", Content: "Example"}},
+		Tags{{Key: "og:title", Content: "Example"}, {Key: "og:image"}},
+	))
+
+	expected := `<meta name="description" content="Example" /><meta property="og:title" content="Example" />`
+	if html != expected {
+		t.Errorf("expected %s, got %s", expected, html)
+	}
+}
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Article represents the Open Graph article metadata.
@@ -106,31 +105,28 @@ func NewArticle(title, url, description, image, publishedTime, modifiedTime, exp
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Article using templ.Component.
 func (art *Article) ToMetaTags() templ.Component {
-	art.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range art.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(art)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Audio as `template.HTML` value for Go's `html/template`.
 func (art *Article) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := art.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Article", art.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Article to w, without going through templ.
+func (art *Article) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, art)
+}
 
-	return html, nil
+// Validate checks the Article against the Open Graph specification.
+func (art *Article) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Article")
+	art.OpenGraphObject.validate(v)
+	v.Date("article:published_time", art.PublishedTime)
+	v.Date("article:modified_time", art.ModifiedTime)
+	v.Date("article:expiration_time", art.ExpirationTime)
+	v.URLs("article:author", art.Author)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for the Article object.
@@ -138,45 +134,35 @@ func (art *Article) ensureDefaults() {
 	art.OpenGraphObject.ensureDefaults("article")
 }
 
-// metaTags returns all meta tags for the Article, including OpenGraphObject fields and article-specific ones.
-func (art *Article) metaTags() []struct {
-	property string
-	content  string
-} {
-	tags := []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "article"},
-		{"og:title", art.Title},
-		{"og:url", art.URL},
-		{"og:description", art.Description},
-		{"og:image", art.Image},
-		{"article:published_time", art.PublishedTime},
-		{"article:modified_time", art.ModifiedTime},
-		{"article:expiration_time", art.ExpirationTime},
-		{"article:section", art.Section},
+// Tags returns all meta tags for the Article, including OpenGraphObject fields and article-specific ones.
+func (art *Article) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "article"),
+		property("og:title", art.Title),
+		property("og:url", art.URL),
+		property("og:description", art.Description),
+		property("og:image", art.Image),
+		property("og:site_name", art.SiteName),
+		property("og:locale", art.Locale),
+		property("article:published_time", art.PublishedTime),
+		property("article:modified_time", art.ModifiedTime),
+		property("article:expiration_time", art.ExpirationTime),
+		property("article:section", art.Section),
 	}
 
 	// Add article:author tags
 	for _, author := range art.Author {
 		if author != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"article:author", author})
+			tags = append(tags, property("article:author", author))
 		}
 	}
 
 	// Add article:tag tags
 	for _, tag := range art.Tag {
 		if tag != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"article:tag", tag})
+			tags = append(tags, property("article:tag", tag))
 		}
 	}
 
-	return tags
+	return nonEmpty(tags)
 }
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Audio represents the Open Graph audio metadata.
@@ -84,31 +83,26 @@ func NewAudio(title, url, description, image, duration, artistURL string) *Audio
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Audio as templ.Component.
 func (audio *Audio) ToMetaTags() templ.Component {
-	audio.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range audio.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(audio)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Audio as `template.HTML` value for Go's `html/template`.
 func (audio *Audio) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := audio.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Audio", audio.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Audio to w, without going through templ.
+func (audio *Audio) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, audio)
+}
 
-	return html, nil
+// Validate checks the Audio against the Open Graph specification.
+func (audio *Audio) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Audio")
+	audio.OpenGraphObject.validate(v)
+	v.Integer("music:duration", audio.Duration)
+	v.URL("music:musician", audio.ArtistURL)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Audio.
@@ -116,21 +110,19 @@ func (audio *Audio) ensureDefaults() {
 	audio.OpenGraphObject.ensureDefaults("music.audio")
 }
 
-// metaTags returns all meta tags for the Audio object, including OpenGraphObject fields and audio-specific ones.
-func (audio *Audio) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "music.audio"},
-		{"og:title", audio.Title},
-		{"og:url", audio.URL},
-		{"og:description", audio.Description},
-		{"og:image", audio.Image},
-		{"music:duration", audio.Duration},
-		{"music:musician", audio.ArtistURL},
+// Tags returns all meta tags for the Audio object, including OpenGraphObject fields and audio-specific ones.
+func (audio *Audio) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "music.audio"),
+		property("og:title", audio.Title),
+		property("og:url", audio.URL),
+		property("og:description", audio.Description),
+		property("og:image", audio.Image),
+		property("og:site_name", audio.SiteName),
+		property("og:locale", audio.Locale),
+		property("music:duration", audio.Duration),
+		property("music:musician", audio.ArtistURL),
 	}
+
+	return nonEmpty(tags)
 }
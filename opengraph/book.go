@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Book represents the Open Graph book metadata.
@@ -96,31 +95,26 @@ func NewBook(title, url, description, image, isbn, releaseDate string, author, t
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Book as templ.Component.
 func (book *Book) ToMetaTags() templ.Component {
-	book.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range book.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(book)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Book as `template.HTML` value for Go's `html/template`.
 func (book *Book) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := book.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Book", book.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Book to w, without going through templ.
+func (book *Book) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, book)
+}
 
-	return html, nil
+// Validate checks the Book against the Open Graph specification.
+func (book *Book) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Book")
+	book.OpenGraphObject.validate(v)
+	v.URLs("book:author", book.Author)
+	v.Date("book:release_date", book.ReleaseDate)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Book.
@@ -128,43 +122,33 @@ func (book *Book) ensureDefaults() {
 	book.OpenGraphObject.ensureDefaults("book")
 }
 
-// metaTags returns all meta tags for the Book object, including OpenGraphObject fields and book-specific ones.
-func (book *Book) metaTags() []struct {
-	property string
-	content  string
-} {
-	tags := []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "book"},
-		{"og:title", book.Title},
-		{"og:url", book.URL},
-		{"og:description", book.Description},
-		{"og:image", book.Image},
-		{"book:isbn", book.ISBN},
-		{"book:release_date", book.ReleaseDate},
+// Tags returns all meta tags for the Book object, including OpenGraphObject fields and book-specific ones.
+func (book *Book) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "book"),
+		property("og:title", book.Title),
+		property("og:url", book.URL),
+		property("og:description", book.Description),
+		property("og:image", book.Image),
+		property("og:site_name", book.SiteName),
+		property("og:locale", book.Locale),
+		property("book:isbn", book.ISBN),
+		property("book:release_date", book.ReleaseDate),
 	}
 
 	// Add book:author tags
 	for _, author := range book.Author {
 		if author != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"book:author", author})
+			tags = append(tags, property("book:author", author))
 		}
 	}
 
 	// Add book:tag tags
 	for _, tag := range book.Tag {
 		if tag != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"book:tag", tag})
+			tags = append(tags, property("book:tag", tag))
 		}
 	}
 
-	return tags
+	return nonEmpty(tags)
 }
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Business represents the Open Graph business metadata.
@@ -114,31 +113,29 @@ func NewBusiness(title, url, description, image, streetAddress, locality, region
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Business as templ.Component.
 func (bus *Business) ToMetaTags() templ.Component {
-	bus.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range bus.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(bus)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Business as `template.HTML` value for Go's `html/template`.
 func (bus *Business) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := bus.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Business", bus.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Business to w, without going through templ.
+func (bus *Business) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, bus)
+}
 
-	return html, nil
+// Validate checks the Business against the Open Graph specification.
+func (bus *Business) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Business")
+	bus.OpenGraphObject.validate(v)
+	v.Required("business:contact_data:street_address", validate.NotEmpty(bus.StreetAddress))
+	v.Required("business:contact_data:locality", validate.NotEmpty(bus.Locality))
+	v.Required("business:contact_data:postal_code", validate.NotEmpty(bus.PostalCode))
+	v.Required("business:contact_data:country_name", validate.NotEmpty(bus.Country))
+	v.URL("business:contact_data:website", bus.Website)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Business.
@@ -146,27 +143,25 @@ func (bus *Business) ensureDefaults() {
 	bus.OpenGraphObject.ensureDefaults("business.business")
 }
 
-// metaTags returns all meta tags for the Business object, including OpenGraphObject fields and business-specific ones.
-func (bus *Business) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "business.business"},
-		{"og:title", bus.Title},
-		{"og:url", bus.URL},
-		{"og:description", bus.Description},
-		{"og:image", bus.Image},
-		{"business:contact_data:street_address", bus.StreetAddress},
-		{"business:contact_data:locality", bus.Locality},
-		{"business:contact_data:region", bus.Region},
-		{"business:contact_data:postal_code", bus.PostalCode},
-		{"business:contact_data:country_name", bus.Country},
-		{"business:contact_data:email", bus.Email},
-		{"business:contact_data:phone_number", bus.PhoneNumber},
-		{"business:contact_data:website", bus.Website},
+// Tags returns all meta tags for the Business object, including OpenGraphObject fields and business-specific ones.
+func (bus *Business) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "business.business"),
+		property("og:title", bus.Title),
+		property("og:url", bus.URL),
+		property("og:description", bus.Description),
+		property("og:image", bus.Image),
+		property("og:site_name", bus.SiteName),
+		property("og:locale", bus.Locale),
+		property("business:contact_data:street_address", bus.StreetAddress),
+		property("business:contact_data:locality", bus.Locality),
+		property("business:contact_data:region", bus.Region),
+		property("business:contact_data:postal_code", bus.PostalCode),
+		property("business:contact_data:country_name", bus.Country),
+		property("business:contact_data:email", bus.Email),
+		property("business:contact_data:phone_number", bus.PhoneNumber),
+		property("business:contact_data:website", bus.Website),
 	}
+
+	return nonEmpty(tags)
 }
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Event represents the Open Graph event metadata.
@@ -89,31 +88,27 @@ func NewEvent(title, url, description, image, startDate, endDate, location strin
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Event as templ.Component.
 func (e *Event) ToMetaTags() templ.Component {
-	e.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range e.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(e)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Event as `template.HTML` value for Go's `html/template`.
 func (e *Event) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := e.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Event", e.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Event to w, without going through templ.
+func (e *Event) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, e)
+}
 
-	return html, nil
+// Validate checks the Event against the Open Graph specification.
+func (e *Event) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Event")
+	e.OpenGraphObject.validate(v)
+	v.Recommended("event:start_date", e.StartDate != "")
+	v.Date("event:start_date", e.StartDate)
+	v.Date("event:end_date", e.EndDate)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Event.
@@ -121,22 +116,20 @@ func (e *Event) ensureDefaults() {
 	e.OpenGraphObject.ensureDefaults("event")
 }
 
-// metaTags returns all meta tags for the Event object, including OpenGraphObject fields and event-specific ones.
-func (e *Event) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "event"},
-		{"og:title", e.Title},
-		{"og:url", e.URL},
-		{"og:description", e.Description},
-		{"og:image", e.Image},
-		{"event:start_date", e.StartDate},
-		{"event:end_date", e.EndDate},
-		{"event:location", e.Location},
+// Tags returns all meta tags for the Event object, including OpenGraphObject fields and event-specific ones.
+func (e *Event) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "event"),
+		property("og:title", e.Title),
+		property("og:url", e.URL),
+		property("og:description", e.Description),
+		property("og:image", e.Image),
+		property("og:site_name", e.SiteName),
+		property("og:locale", e.Locale),
+		property("event:start_date", e.StartDate),
+		property("event:end_date", e.EndDate),
+		property("event:location", e.Location),
 	}
+
+	return nonEmpty(tags)
 }
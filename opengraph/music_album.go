@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // MusicAlbum represents the Open Graph music album metadata.
@@ -90,31 +89,26 @@ func NewMusicAlbum(title, url, description, image, releaseDate, genre string, mu
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Music Album as templ.Component.
 func (ma *MusicAlbum) ToMetaTags() templ.Component {
-	ma.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range ma.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(ma)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Music Album as `template.HTML` value for Go's `html/template`.
 func (ma *MusicAlbum) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := ma.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.MusicAlbum", ma.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph MusicAlbum to w, without going through templ.
+func (ma *MusicAlbum) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, ma)
+}
 
-	return html, nil
+// Validate checks the MusicAlbum against the Open Graph specification.
+func (ma *MusicAlbum) Validate() []teseo.Issue {
+	v := validate.New("opengraph.MusicAlbum")
+	ma.OpenGraphObject.validate(v)
+	v.URLs("music:musician", ma.Musician)
+	v.Date("music:release_date", ma.ReleaseDate)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for MusicAlbum.
@@ -122,33 +116,26 @@ func (ma *MusicAlbum) ensureDefaults() {
 	ma.OpenGraphObject.ensureDefaults("music.album")
 }
 
-// metaTags returns all meta tags for the MusicAlbum object, including OpenGraphObject fields and music-specific ones.
-func (ma *MusicAlbum) metaTags() []struct {
-	property string
-	content  string
-} {
-	tags := []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "music.album"},
-		{"og:title", ma.Title},
-		{"og:url", ma.URL},
-		{"og:description", ma.Description},
-		{"og:image", ma.Image},
-		{"music:release_date", ma.ReleaseDate},
-		{"music:genre", ma.Genre},
+// Tags returns all meta tags for the MusicAlbum object, including OpenGraphObject fields and music-specific ones.
+func (ma *MusicAlbum) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "music.album"),
+		property("og:title", ma.Title),
+		property("og:url", ma.URL),
+		property("og:description", ma.Description),
+		property("og:image", ma.Image),
+		property("og:site_name", ma.SiteName),
+		property("og:locale", ma.Locale),
+		property("music:release_date", ma.ReleaseDate),
+		property("music:genre", ma.Genre),
 	}
 
 	// Add music:musician tags
 	for _, musician := range ma.Musician {
 		if musician != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"music:musician", musician})
+			tags = append(tags, property("music:musician", musician))
 		}
 	}
 
-	return tags
+	return nonEmpty(tags)
 }
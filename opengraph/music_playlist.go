@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // MusicPlaylist represents the Open Graph music playlist metadata.
@@ -85,31 +84,26 @@ func NewMusicPlaylist(title, url, description, image string, songURLs []string,
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Music Playlist as templ.Component.
 func (mp *MusicPlaylist) ToMetaTags() templ.Component {
-	mp.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range mp.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(mp)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Music Playlist as `template.HTML` value for Go's `html/template`.
 func (mp *MusicPlaylist) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := mp.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.MusicPlaylist", mp.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph MusicPlaylist to w, without going through templ.
+func (mp *MusicPlaylist) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, mp)
+}
 
-	return html, nil
+// Validate checks the MusicPlaylist against the Open Graph specification.
+func (mp *MusicPlaylist) Validate() []teseo.Issue {
+	v := validate.New("opengraph.MusicPlaylist")
+	mp.OpenGraphObject.validate(v)
+	v.URLs("music:song", mp.SongURLs)
+	v.Integer("music:duration", mp.Duration)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for MusicPlaylist.
@@ -117,32 +111,25 @@ func (mp *MusicPlaylist) ensureDefaults() {
 	mp.OpenGraphObject.ensureDefaults("music.playlist")
 }
 
-// metaTags returns all meta tags for the MusicPlaylist object, including OpenGraphObject fields and music-specific ones.
-func (mp *MusicPlaylist) metaTags() []struct {
-	property string
-	content  string
-} {
-	tags := []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "music.playlist"},
-		{"og:title", mp.Title},
-		{"og:url", mp.URL},
-		{"og:description", mp.Description},
-		{"og:image", mp.Image},
-		{"music:duration", mp.Duration},
+// Tags returns all meta tags for the MusicPlaylist object, including OpenGraphObject fields and music-specific ones.
+func (mp *MusicPlaylist) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "music.playlist"),
+		property("og:title", mp.Title),
+		property("og:url", mp.URL),
+		property("og:description", mp.Description),
+		property("og:image", mp.Image),
+		property("og:site_name", mp.SiteName),
+		property("og:locale", mp.Locale),
+		property("music:duration", mp.Duration),
 	}
 
 	// Add music:song tags for each song URL
 	for _, songURL := range mp.SongURLs {
 		if songURL != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"music:song", songURL})
+			tags = append(tags, property("music:song", songURL))
 		}
 	}
 
-	return tags
+	return nonEmpty(tags)
 }
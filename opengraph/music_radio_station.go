@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // MusicRadioStation represents the Open Graph music radio station metadata.
@@ -74,31 +73,24 @@ func NewMusicRadioStation(title, url, description, image string) *MusicRadioStat
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Music Radio Station as templ.Component.
 func (mrs *MusicRadioStation) ToMetaTags() templ.Component {
-	mrs.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range mrs.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(mrs)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Music Radio Station as `template.HTML` value for Go's `html/template`.
 func (mrs *MusicRadioStation) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := mrs.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.MusicRadioStation", mrs.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph MusicRadioStation to w, without going through templ.
+func (mrs *MusicRadioStation) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, mrs)
+}
 
-	return html, nil
+// Validate checks the MusicRadioStation against the Open Graph specification.
+func (mrs *MusicRadioStation) Validate() []teseo.Issue {
+	v := validate.New("opengraph.MusicRadioStation")
+	mrs.OpenGraphObject.validate(v)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for MusicRadioStation.
@@ -106,19 +98,17 @@ func (mrs *MusicRadioStation) ensureDefaults() {
 	mrs.OpenGraphObject.ensureDefaults("music.radio_station")
 }
 
-// metaTags returns all meta tags for the MusicRadioStation object, including OpenGraphObject fields.
-func (mrs *MusicRadioStation) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "music.radio_station"},
-		{"og:title", mrs.Title},
-		{"og:url", mrs.URL},
-		{"og:description", mrs.Description},
-		{"og:image", mrs.Image},
+// Tags returns all meta tags for the MusicRadioStation object, including OpenGraphObject fields.
+func (mrs *MusicRadioStation) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "music.radio_station"),
+		property("og:title", mrs.Title),
+		property("og:url", mrs.URL),
+		property("og:description", mrs.Description),
+		property("og:image", mrs.Image),
+		property("og:site_name", mrs.SiteName),
+		property("og:locale", mrs.Locale),
 	}
+
+	return nonEmpty(tags)
 }
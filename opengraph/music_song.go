@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // MusicSong represents the Open Graph music song metadata.
@@ -93,31 +92,27 @@ func NewMusicSong(title, url, description, image, duration, albumURL string, mus
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Music Song as templ.Component.
 func (ms *MusicSong) ToMetaTags() templ.Component {
-	ms.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range ms.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(ms)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Music Song as `template.HTML` value for Go's `html/template`.
 func (ms *MusicSong) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := ms.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.MusicSong", ms.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph MusicSong to w, without going through templ.
+func (ms *MusicSong) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, ms)
+}
 
-	return html, nil
+// Validate checks the MusicSong against the Open Graph specification.
+func (ms *MusicSong) Validate() []teseo.Issue {
+	v := validate.New("opengraph.MusicSong")
+	ms.OpenGraphObject.validate(v)
+	v.Integer("music:duration", ms.Duration)
+	v.URL("music:album", ms.AlbumURL)
+	v.URLs("music:musician", ms.MusicianURLs)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for MusicSong.
@@ -125,33 +120,26 @@ func (ms *MusicSong) ensureDefaults() {
 	ms.OpenGraphObject.ensureDefaults("music.song")
 }
 
-// metaTags returns all meta tags for the MusicSong object, including OpenGraphObject fields and music-specific ones.
-func (ms *MusicSong) metaTags() []struct {
-	property string
-	content  string
-} {
-	tags := []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "music.song"},
-		{"og:title", ms.Title},
-		{"og:url", ms.URL},
-		{"og:description", ms.Description},
-		{"og:image", ms.Image},
-		{"music:duration", ms.Duration},
-		{"music:album", ms.AlbumURL},
+// Tags returns all meta tags for the MusicSong object, including OpenGraphObject fields and music-specific ones.
+func (ms *MusicSong) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "music.song"),
+		property("og:title", ms.Title),
+		property("og:url", ms.URL),
+		property("og:description", ms.Description),
+		property("og:image", ms.Image),
+		property("og:site_name", ms.SiteName),
+		property("og:locale", ms.Locale),
+		property("music:duration", ms.Duration),
+		property("music:album", ms.AlbumURL),
 	}
 
 	// Add music:musician tags for each musician URL
 	for _, musicianURL := range ms.MusicianURLs {
 		if musicianURL != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"music:musician", musicianURL})
+			tags = append(tags, property("music:musician", musicianURL))
 		}
 	}
 
-	return tags
+	return nonEmpty(tags)
 }
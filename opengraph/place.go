@@ -1,14 +1,13 @@
 package opengraph
 
 import (
-	"context"
 	"fmt"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Place represents the Open Graph place metadata.
@@ -110,31 +109,31 @@ func NewPlace(title, url, description, image string, latitude, longitude float64
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Place as templ.Component.
 func (place *Place) ToMetaTags() templ.Component {
-	place.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range place.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(place)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Place as `template.HTML` value for Go's `html/template`.
 func (place *Place) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := place.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Place", place.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Place to w, without going through templ.
+func (place *Place) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, place)
+}
 
-	return html, nil
+// Validate checks the Place against the Open Graph specification.
+func (place *Place) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Place")
+	place.OpenGraphObject.validate(v)
+	v.Required("place:location", place.Latitude != 0 || place.Longitude != 0)
+	if place.Latitude < -90 || place.Latitude > 90 {
+		v.Add(teseo.SeverityError, "place:location:latitude", "range", fmt.Sprintf("latitude %v is out of range [-90, 90]", place.Latitude))
+	}
+	if place.Longitude < -180 || place.Longitude > 180 {
+		v.Add(teseo.SeverityError, "place:location:longitude", "range", fmt.Sprintf("longitude %v is out of range [-180, 180]", place.Longitude))
+	}
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Place.
@@ -142,26 +141,24 @@ func (place *Place) ensureDefaults() {
 	place.OpenGraphObject.ensureDefaults("place")
 }
 
-// metaTags returns all meta tags for the Place object, including OpenGraphObject fields and place-specific ones.
-func (place *Place) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "place"},
-		{"og:title", place.Title},
-		{"og:url", place.URL},
-		{"og:description", place.Description},
-		{"og:image", place.Image},
-		{"place:location:latitude", fmt.Sprintf("%.4f", place.Latitude)},
-		{"place:location:longitude", fmt.Sprintf("%.4f", place.Longitude)},
-		{"place:contact_data:street_address", place.StreetAddress},
-		{"place:contact_data:locality", place.Locality},
-		{"place:contact_data:region", place.Region},
-		{"place:contact_data:postal_code", place.PostalCode},
-		{"place:contact_data:country_name", place.Country},
+// Tags returns all meta tags for the Place object, including OpenGraphObject fields and place-specific ones.
+func (place *Place) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "place"),
+		property("og:title", place.Title),
+		property("og:url", place.URL),
+		property("og:description", place.Description),
+		property("og:image", place.Image),
+		property("og:site_name", place.SiteName),
+		property("og:locale", place.Locale),
+		property("place:location:latitude", fmt.Sprintf("%.4f", place.Latitude)),
+		property("place:location:longitude", fmt.Sprintf("%.4f", place.Longitude)),
+		property("place:contact_data:street_address", place.StreetAddress),
+		property("place:contact_data:locality", place.Locality),
+		property("place:contact_data:region", place.Region),
+		property("place:contact_data:postal_code", place.PostalCode),
+		property("place:contact_data:country_name", place.Country),
 	}
+
+	return nonEmpty(tags)
 }
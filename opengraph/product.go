@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Product represents the Open Graph product metadata.
@@ -84,31 +83,28 @@ func NewProduct(title, url, description, image, price, priceCurrency string) *Pr
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Product as templ.Component.
 func (p *Product) ToMetaTags() templ.Component {
-	p.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range p.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(p)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Product as `template.HTML` value for Go's `html/template`.
 func (p *Product) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := p.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Product", p.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Product to w, without going through templ.
+func (p *Product) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, p)
+}
 
-	return html, nil
+// Validate checks the Product against the Open Graph specification.
+func (p *Product) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Product")
+	p.OpenGraphObject.validate(v)
+	v.Recommended("product:price:amount", p.Price != "")
+	if p.Price != "" {
+		v.Required("product:price:currency", p.PriceCurrency != "")
+	}
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Product.
@@ -116,21 +112,19 @@ func (p *Product) ensureDefaults() {
 	p.OpenGraphObject.ensureDefaults("product")
 }
 
-// metaTags returns all meta tags for the Product object, including OpenGraphObject fields and product-specific ones.
-func (p *Product) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "product"},
-		{"og:title", p.Title},
-		{"og:url", p.URL},
-		{"og:description", p.Description},
-		{"og:image", p.Image},
-		{"product:price:amount", p.Price},
-		{"product:price:currency", p.PriceCurrency},
+// Tags returns all meta tags for the Product object, including OpenGraphObject fields and product-specific ones.
+func (p *Product) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "product"),
+		property("og:title", p.Title),
+		property("og:url", p.URL),
+		property("og:description", p.Description),
+		property("og:image", p.Image),
+		property("og:site_name", p.SiteName),
+		property("og:locale", p.Locale),
+		property("product:price:amount", p.Price),
+		property("product:price:currency", p.PriceCurrency),
 	}
+
+	return nonEmpty(tags)
 }
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // ProductGroup represents the Open Graph product group metadata.
@@ -86,32 +85,25 @@ func NewProductGroup(title, url, description, image string, products []string) *
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Product Group as templ.Component.
 func (pg *ProductGroup) ToMetaTags() templ.Component {
-	pg.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range pg.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-
-		return nil
-	})
+	return teseo.MetaTags(pg)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Product Group as `template.HTML` value for Go's `html/template`.
 func (pg *ProductGroup) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := pg.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.ProductGroup", pg.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph ProductGroup to w, without going through templ.
+func (pg *ProductGroup) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, pg)
+}
 
-	return html, nil
+// Validate checks the ProductGroup against the Open Graph specification.
+func (pg *ProductGroup) Validate() []teseo.Issue {
+	v := validate.New("opengraph.ProductGroup")
+	pg.OpenGraphObject.validate(v)
+	v.URLs("product:group_item", pg.Products)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for ProductGroup.
@@ -119,31 +111,24 @@ func (pg *ProductGroup) ensureDefaults() {
 	pg.OpenGraphObject.ensureDefaults("product.group")
 }
 
-// metaTags returns all meta tags for the ProductGroup object, including OpenGraphObject fields and product-specific ones.
-func (pg *ProductGroup) metaTags() []struct {
-	property string
-	content  string
-} {
-	tags := []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "product.group"},
-		{"og:title", pg.Title},
-		{"og:url", pg.URL},
-		{"og:description", pg.Description},
-		{"og:image", pg.Image},
+// Tags returns all meta tags for the ProductGroup object, including OpenGraphObject fields and product-specific ones.
+func (pg *ProductGroup) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "product.group"),
+		property("og:title", pg.Title),
+		property("og:url", pg.URL),
+		property("og:description", pg.Description),
+		property("og:image", pg.Image),
+		property("og:site_name", pg.SiteName),
+		property("og:locale", pg.Locale),
 	}
 
 	// Add product:group_item tags for each product in the group
 	for _, product := range pg.Products {
 		if product != "" {
-			tags = append(tags, struct {
-				property string
-				content  string
-			}{"product:group_item", product})
+			tags = append(tags, property("product:group_item", product))
 		}
 	}
 
-	return tags
+	return nonEmpty(tags)
 }
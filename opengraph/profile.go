@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Profile represents the Open Graph profile metadata.
@@ -94,32 +93,24 @@ func NewProfile(title string, firstName string, lastName string, username string
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Profile as templ.Component.
 func (p *Profile) ToMetaTags() templ.Component {
-	p.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range p.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-
-		return nil
-	})
+	return teseo.MetaTags(p)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Profile as `template.HTML` value for Go's `html/template`.
 func (p *Profile) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := p.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Profile", p.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Profile to w, without going through templ.
+func (p *Profile) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, p)
+}
 
-	return html, nil
+// Validate checks the Profile against the Open Graph specification.
+func (p *Profile) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Profile")
+	p.OpenGraphObject.validate(v)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Profile.
@@ -127,23 +118,21 @@ func (p *Profile) ensureDefaults() {
 	p.OpenGraphObject.ensureDefaults("profile")
 }
 
-// metaTags returns all meta tags for the Profile object, including OpenGraphObject fields and profile-specific ones.
-func (p *Profile) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "profile"},
-		{"og:title", p.Title},
-		{"og:url", p.URL},
-		{"profile:first_name", p.FirstName},
-		{"profile:last_name", p.LastName},
-		{"profile:username", p.Username},
-		{"profile:gender", p.Gender},
-		{"og:description", p.Description},
-		{"og:image", p.Image},
+// Tags returns all meta tags for the Profile object, including OpenGraphObject fields and profile-specific ones.
+func (p *Profile) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "profile"),
+		property("og:title", p.Title),
+		property("og:url", p.URL),
+		property("profile:first_name", p.FirstName),
+		property("profile:last_name", p.LastName),
+		property("profile:username", p.Username),
+		property("profile:gender", p.Gender),
+		property("og:description", p.Description),
+		property("og:image", p.Image),
+		property("og:site_name", p.SiteName),
+		property("og:locale", p.Locale),
 	}
+
+	return nonEmpty(tags)
 }
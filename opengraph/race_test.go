@@ -0,0 +1,69 @@
+package opengraph
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// sharedObjects returns one object of each Open Graph type without the og:type set
+func sharedObjects() map[string]interface {
+	ToMetaTags() templ.Component
+} {
+	object := OpenGraphObject{Title: "Example", URL: "https://www.example.com", Image: "https://www.example.com/image.jpg"}
+
+	return map[string]interface {
+		ToMetaTags() templ.Component
+	}{
+		"Article":           &Article{OpenGraphObject: object, Tag: []string{"go"}},
+		"Audio":             &Audio{OpenGraphObject: object},
+		"Book":              &Book{OpenGraphObject: object, Author: []string{"https://www.example.com/author"}},
+		"Business":          &Business{OpenGraphObject: object},
+		"Event":             &Event{OpenGraphObject: object},
+		"MusicAlbum":        &MusicAlbum{OpenGraphObject: object},
+		"MusicPlaylist":     &MusicPlaylist{OpenGraphObject: object},
+		"MusicRadioStation": &MusicRadioStation{OpenGraphObject: object},
+		"MusicSong":         &MusicSong{OpenGraphObject: object},
+		"Place":             &Place{OpenGraphObject: object},
+		"Product":           &Product{OpenGraphObject: object},
+		"ProductGroup":      &ProductGroup{OpenGraphObject: object},
+		"Profile":           &Profile{OpenGraphObject: object},
+		"Restaurant":        &Restaurant{OpenGraphObject: object},
+		"Video":             &Video{OpenGraphObject: object},
+		"VideoEpisode":      &VideoEpisode{OpenGraphObject: object},
+		"VideoMovie":        &VideoMovie{OpenGraphObject: object},
+		"WebSite":           &WebSite{OpenGraphObject: object},
+	}
+}
+
+// TestConcurrentToMetaTags renders shared objects from many goroutines, run it with -race.
+// Rendering must not modify the objects.
+func TestConcurrentToMetaTags(t *testing.T) {
+	for name, object := range sharedObjects() {
+		t.Run(name, func(t *testing.T) {
+			before, err := json.Marshal(object)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := templ.ToGoHTML(context.Background(), object.ToMetaTags()); err != nil {
+						t.Errorf("ToMetaTags failed: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if after, _ := json.Marshal(object); string(after) != string(before) {
+				t.Errorf("rendering modified the %s.\nBefore:\n%s\nAfter:\n%s", name, before, after)
+			}
+		})
+	}
+}
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Restaurant represents the Open Graph restaurant metadata.
@@ -114,31 +113,26 @@ func NewRestaurant(title, url, description, image, streetAddress, locality, regi
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Restaurant as templ.Component.
 func (restaurant *Restaurant) ToMetaTags() templ.Component {
-	restaurant.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range restaurant.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(restaurant)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Restaurant as `template.HTML` value for Go's `html/template`.
 func (restaurant *Restaurant) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := restaurant.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Restaurant", restaurant.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Restaurant to w, without going through templ.
+func (restaurant *Restaurant) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, restaurant)
+}
 
-	return html, nil
+// Validate checks the Restaurant against the Open Graph specification.
+func (restaurant *Restaurant) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Restaurant")
+	restaurant.OpenGraphObject.validate(v)
+	v.URL("restaurant:menu", restaurant.MenuURL)
+	v.URL("restaurant:reservation", restaurant.ReservationURL)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Restaurant.
@@ -146,27 +140,25 @@ func (restaurant *Restaurant) ensureDefaults() {
 	restaurant.OpenGraphObject.ensureDefaults("restaurant")
 }
 
-// metaTags returns all meta tags for the Restaurant object, including OpenGraphObject fields and restaurant-specific ones.
-func (restaurant *Restaurant) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "restaurant"},
-		{"og:title", restaurant.Title},
-		{"og:url", restaurant.URL},
-		{"og:description", restaurant.Description},
-		{"og:image", restaurant.Image},
-		{"place:contact_data:street_address", restaurant.StreetAddress},
-		{"place:contact_data:locality", restaurant.Locality},
-		{"place:contact_data:region", restaurant.Region},
-		{"place:contact_data:postal_code", restaurant.PostalCode},
-		{"place:contact_data:country_name", restaurant.Country},
-		{"place:contact_data:phone_number", restaurant.Phone},
-		{"restaurant:menu", restaurant.MenuURL},
-		{"restaurant:reservation", restaurant.ReservationURL},
+// Tags returns all meta tags for the Restaurant object, including OpenGraphObject fields and restaurant-specific ones.
+func (restaurant *Restaurant) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "restaurant"),
+		property("og:title", restaurant.Title),
+		property("og:url", restaurant.URL),
+		property("og:description", restaurant.Description),
+		property("og:image", restaurant.Image),
+		property("og:site_name", restaurant.SiteName),
+		property("og:locale", restaurant.Locale),
+		property("place:contact_data:street_address", restaurant.StreetAddress),
+		property("place:contact_data:locality", restaurant.Locality),
+		property("place:contact_data:region", restaurant.Region),
+		property("place:contact_data:postal_code", restaurant.PostalCode),
+		property("place:contact_data:country_name", restaurant.Country),
+		property("place:contact_data:phone_number", restaurant.Phone),
+		property("restaurant:menu", restaurant.MenuURL),
+		property("restaurant:reservation", restaurant.ReservationURL),
 	}
+
+	return nonEmpty(tags)
 }
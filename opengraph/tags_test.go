@@ -0,0 +1,34 @@
+package opengraph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+// TestObjectsImplementMetaTagger tests that every Open Graph type exposes the tags it renders
+func TestObjectsImplementMetaTagger(t *testing.T) {
+	for name, object := range sharedObjects() {
+		tagger, ok := object.(teseo.MetaTagger)
+		if !ok {
+			t.Errorf("%s does not implement teseo.MetaTagger", name)
+			continue
+		}
+
+		html, err := teseo.RenderGoHTML(name, object.ToMetaTags())
+		if err != nil {
+			t.Fatalf("%s: ToMetaTags failed: %v", name, err)
+		}
+
+		tags := tagger.Tags()
+		if n := strings.Count(string(html), "<meta "); n != len(tags) {
+			t.Errorf("%s: expected %d rendered tags, got %d", name, len(tags), n)
+		}
+		for _, tag := range tags {
+			if tag.Attribute != teseo.MetaProperty || tag.Content == "" {
+				t.Errorf("%s: unexpected tag %+v", name, tag)
+			}
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package opengraph
 
+import (
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+)
+
 // OpenGraphObject represents common Open Graph metadata.
 // For more details about the meaning of the properties see: https://ogp.me/#metadata
 type OpenGraphObject struct {
@@ -8,6 +13,10 @@ type OpenGraphObject struct {
 	URL         string // og:url, the canonical URL of the object
 	Description string // og:description, a brief description of the object
 	Image       string // og:image, URL to the image of the object
+	SiteName    string // og:site_name, the name of the overall site
+	Locale      string // og:locale, the locale of the object, e.g. "en_US"
+
+	mergedTitle teseo.MergedTitle
 }
 
 // ensureDefaults sets default values for OpenGraphObject if they are not already set.
@@ -16,3 +25,52 @@ func (og *OpenGraphObject) ensureDefaults(defaultType string) {
 		og.Type = defaultType
 	}
 }
+
+// MergeSite fills the empty og:image, og:site_name and og:locale with the Site defaults and formats
+// og:title with the title template of the Site. It is promoted to every Open Graph type, making them
+// implement teseo.SiteMerger.
+func (og *OpenGraphObject) MergeSite(site *teseo.Site) {
+	if site == nil {
+		return
+	}
+	if og.Title != "" {
+		site.MergeTitle(&og.Title, &og.mergedTitle)
+	}
+	fill(&og.Image, site.Image)
+	fill(&og.SiteName, site.Name)
+	fill(&og.Locale, site.Locale)
+}
+
+// fill sets *field to value when it is empty.
+func fill(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
+// validate checks the basic metadata required for every Open Graph object.
+// For more details see: https://ogp.me/#metadata
+func (og *OpenGraphObject) validate(v *validate.Validator) {
+	v.Required("og:title", validate.NotEmpty(og.Title))
+	v.Required("og:url", og.URL != "")
+	v.Required("og:image", og.Image != "")
+	v.Recommended("og:description", validate.NotEmpty(og.Description))
+	v.URL("og:url", og.URL)
+	v.URL("og:image", og.Image)
+}
+
+// property returns an Open Graph meta tag, written with the `property` attribute.
+func property(key, content string) teseo.MetaTag {
+	return teseo.MetaTag{Attribute: teseo.MetaProperty, Key: key, Content: content}
+}
+
+// nonEmpty returns the tags with a content, i.e. the tags actually rendered.
+func nonEmpty(tags []teseo.MetaTag) []teseo.MetaTag {
+	result := tags[:0]
+	for _, tag := range tags {
+		if tag.Content != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
@@ -0,0 +1,43 @@
+package opengraph
+
+import (
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+// TestObjectsImplementValidator tests that every Open Graph type exposes Validate
+func TestObjectsImplementValidator(t *testing.T) {
+	for name, object := range sharedObjects() {
+		if _, ok := object.(teseo.Validator); !ok {
+			t.Errorf("%s does not implement teseo.Validator", name)
+		}
+	}
+}
+
+// TestArticleValidate tests the basic and the article specific rules
+func TestArticleValidate(t *testing.T) {
+	article := &Article{
+		OpenGraphObject: OpenGraphObject{Title: "Example", URL: "https://www.example.com/article"},
+		PublishedTime:   "15/09/2024",
+	}
+
+	rules := map[string]teseo.Severity{}
+	for _, issue := range article.Validate() {
+		rules[issue.Rule] = issue.Severity
+	}
+
+	expected := map[string]teseo.Severity{
+		"opengraph.Article.og:image.required":           teseo.SeverityError,
+		"opengraph.Article.og:description.recommended":  teseo.SeverityWarning,
+		"opengraph.Article.article:published_time.date": teseo.SeverityError,
+	}
+	for rule, severity := range expected {
+		if rules[rule] != severity {
+			t.Errorf("expected %s issue %s, got %v", severity, rule, rules)
+		}
+	}
+	if len(rules) != len(expected) {
+		t.Errorf("expected %d issues, got %v", len(expected), rules)
+	}
+}
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Video represents the Open Graph video metadata.
@@ -97,41 +96,28 @@ func NewVideo(title, url, description, image, duration string, actorURLs []strin
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Video using templ.Component.
 func (video *Video) ToMetaTags() templ.Component {
-	video.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range video.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-
-		// Write video:actor meta tags for each actor URL
-		for _, actorURL := range video.ActorURLs {
-			if actorURL != "" {
-				if err := teseo.WriteMetaTag(w, "video:actor", actorURL); err != nil {
-					return err
-				}
-			}
-		}
-
-		return nil
-	})
+	return teseo.MetaTags(video)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Video as `template.HTML` value for Go's `html/template`.
 func (video *Video) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := video.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.Video", video.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph Video to w, without going through templ.
+func (video *Video) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, video)
+}
 
-	return html, nil
+// Validate checks the Video against the Open Graph specification.
+func (video *Video) Validate() []teseo.Issue {
+	v := validate.New("opengraph.Video")
+	video.OpenGraphObject.validate(v)
+	v.Integer("video:duration", video.Duration)
+	v.URLs("video:actor", video.ActorURLs)
+	v.URL("video:director", video.DirectorURL)
+	v.Date("video:release_date", video.ReleaseDate)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Video.
@@ -139,22 +125,20 @@ func (video *Video) ensureDefaults() {
 	video.OpenGraphObject.ensureDefaults("video.movie")
 }
 
-// metaTags returns all meta tags for the Video object, including OpenGraphObject fields and video-specific ones.
-func (video *Video) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "video.movie"},
-		{"og:title", video.Title},
-		{"og:url", video.URL},
-		{"og:description", video.Description},
-		{"og:image", video.Image},
-		{"video:duration", video.Duration},
-		{"video:director", video.DirectorURL},
-		{"video:release_date", video.ReleaseDate},
+// Tags returns all meta tags for the Video object, including OpenGraphObject fields and video-specific ones.
+func (video *Video) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "video.movie"),
+		property("og:title", video.Title),
+		property("og:url", video.URL),
+		property("og:description", video.Description),
+		property("og:image", video.Image),
+		property("og:site_name", video.SiteName),
+		property("og:locale", video.Locale),
+		property("video:duration", video.Duration),
+		property("video:director", video.DirectorURL),
+		property("video:release_date", video.ReleaseDate),
 	}
+
+	return nonEmpty(tags)
 }
@@ -1,14 +1,13 @@
 package opengraph
 
 import (
-	"context"
 	"fmt"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // VideoEpisode represents the Open Graph video episode metadata.
@@ -106,41 +105,29 @@ func NewVideoEpisode(title, url, description, image, duration, seriesURL string,
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Video Episode as templ.Component.
 func (ve *VideoEpisode) ToMetaTags() templ.Component {
-	ve.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range ve.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-
-		// Write video:actor meta tags for each actor URL
-		for _, actorURL := range ve.ActorURLs {
-			if actorURL != "" {
-				if err := teseo.WriteMetaTag(w, "video:actor", actorURL); err != nil {
-					return err
-				}
-			}
-		}
-
-		return nil
-	})
+	return teseo.MetaTags(ve)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Video Episode as `template.HTML` value for Go's `html/template`.
 func (ve *VideoEpisode) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := ve.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.VideoEpisode", ve.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph VideoEpisode to w, without going through templ.
+func (ve *VideoEpisode) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, ve)
+}
 
-	return html, nil
+// Validate checks the VideoEpisode against the Open Graph specification.
+func (ve *VideoEpisode) Validate() []teseo.Issue {
+	v := validate.New("opengraph.VideoEpisode")
+	ve.OpenGraphObject.validate(v)
+	v.URL("video:series", ve.SeriesURL)
+	v.Integer("video:duration", ve.Duration)
+	v.URLs("video:actor", ve.ActorURLs)
+	v.URL("video:director", ve.DirectorURL)
+	v.Date("video:release_date", ve.ReleaseDate)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for VideoEpisode.
@@ -148,24 +135,22 @@ func (ve *VideoEpisode) ensureDefaults() {
 	ve.OpenGraphObject.ensureDefaults("video.episode")
 }
 
-// metaTags returns all meta tags for the VideoEpisode object, including OpenGraphObject fields and video episode-specific ones.
-func (ve *VideoEpisode) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "video.episode"},
-		{"og:title", ve.Title},
-		{"og:url", ve.URL},
-		{"og:description", ve.Description},
-		{"og:image", ve.Image},
-		{"video:duration", ve.Duration},
-		{"video:director", ve.DirectorURL},
-		{"video:release_date", ve.ReleaseDate},
-		{"video:series", ve.SeriesURL},
-		{"video:episode", fmt.Sprintf("%d", ve.EpisodeNumber)},
+// Tags returns all meta tags for the VideoEpisode object, including OpenGraphObject fields and video episode-specific ones.
+func (ve *VideoEpisode) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "video.episode"),
+		property("og:title", ve.Title),
+		property("og:url", ve.URL),
+		property("og:description", ve.Description),
+		property("og:image", ve.Image),
+		property("og:site_name", ve.SiteName),
+		property("og:locale", ve.Locale),
+		property("video:duration", ve.Duration),
+		property("video:director", ve.DirectorURL),
+		property("video:release_date", ve.ReleaseDate),
+		property("video:series", ve.SeriesURL),
+		property("video:episode", fmt.Sprintf("%d", ve.EpisodeNumber)),
 	}
+
+	return nonEmpty(tags)
 }
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // VideoMovie represents the Open Graph video movie metadata.
@@ -95,41 +94,28 @@ func NewVideoMovie(title, url, description, image, duration string, actorURLs []
 
 // ToMetaTags generates the HTML meta tags for the Open Graph Video Movie as templ.Component.
 func (vm *VideoMovie) ToMetaTags() templ.Component {
-	vm.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range vm.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-
-		// Write video:actor meta tags for each actor URL
-		for _, actorURL := range vm.ActorURLs {
-			if actorURL != "" {
-				if err := teseo.WriteMetaTag(w, "video:actor", actorURL); err != nil {
-					return err
-				}
-			}
-		}
-
-		return nil
-	})
+	return teseo.MetaTags(vm)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph Video Movie as `template.HTML` value for Go's `html/template`.
 func (vm *VideoMovie) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := vm.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.VideoMovie", vm.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph VideoMovie to w, without going through templ.
+func (vm *VideoMovie) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, vm)
+}
 
-	return html, nil
+// Validate checks the VideoMovie against the Open Graph specification.
+func (vm *VideoMovie) Validate() []teseo.Issue {
+	v := validate.New("opengraph.VideoMovie")
+	vm.OpenGraphObject.validate(v)
+	v.Integer("video:duration", vm.Duration)
+	v.URLs("video:actor", vm.ActorURLs)
+	v.URL("video:director", vm.DirectorURL)
+	v.Date("video:release_date", vm.ReleaseDate)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for VideoMovie.
@@ -137,22 +123,20 @@ func (vm *VideoMovie) ensureDefaults() {
 	vm.OpenGraphObject.ensureDefaults("video.movie")
 }
 
-// metaTags returns all meta tags for the VideoMovie object, including OpenGraphObject fields and video movie-specific ones.
-func (vm *VideoMovie) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "video.movie"},
-		{"og:title", vm.Title},
-		{"og:url", vm.URL},
-		{"og:description", vm.Description},
-		{"og:image", vm.Image},
-		{"video:duration", vm.Duration},
-		{"video:director", vm.DirectorURL},
-		{"video:release_date", vm.ReleaseDate},
+// Tags returns all meta tags for the VideoMovie object, including OpenGraphObject fields and video movie-specific ones.
+func (vm *VideoMovie) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "video.movie"),
+		property("og:title", vm.Title),
+		property("og:url", vm.URL),
+		property("og:description", vm.Description),
+		property("og:image", vm.Image),
+		property("og:site_name", vm.SiteName),
+		property("og:locale", vm.Locale),
+		property("video:duration", vm.Duration),
+		property("video:director", vm.DirectorURL),
+		property("video:release_date", vm.ReleaseDate),
 	}
+
+	return nonEmpty(tags)
 }
@@ -1,13 +1,12 @@
 package opengraph
 
 import (
-	"context"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // WebSite represents the Open Graph website metadata.
@@ -74,31 +73,24 @@ func NewWebSite(title, url, description, image string) *WebSite {
 
 // ToMetaTags generates the HTML meta tags for the Open Graph WebSite using templ.Component.
 func (ws *WebSite) ToMetaTags() templ.Component {
-	ws.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		for _, tag := range ws.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.property, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(ws)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Open Graph WebSite as `template.HTML` value for Go's `html/template`.
 func (ws *WebSite) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := ws.ToMetaTags()
+	return teseo.RenderGoHTML("opengraph.WebSite", ws.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Open Graph WebSite to w, without going through templ.
+func (ws *WebSite) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, ws)
+}
 
-	return html, nil
+// Validate checks the WebSite against the Open Graph specification.
+func (ws *WebSite) Validate() []teseo.Issue {
+	v := validate.New("opengraph.WebSite")
+	ws.OpenGraphObject.validate(v)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for WebSite.
@@ -106,19 +98,17 @@ func (ws *WebSite) ensureDefaults() {
 	ws.OpenGraphObject.ensureDefaults("website")
 }
 
-// metaTags returns the meta tags for the WebSite as a slice of property-content pairs.
-func (ws *WebSite) metaTags() []struct {
-	property string
-	content  string
-} {
-	return []struct {
-		property string
-		content  string
-	}{
-		{"og:type", "website"},
-		{"og:title", ws.Title},
-		{"og:url", ws.URL},
-		{"og:description", ws.Description},
-		{"og:image", ws.Image},
+// Tags returns the meta tags for the WebSite as a slice of property-content pairs.
+func (ws *WebSite) Tags() []teseo.MetaTag {
+	tags := []teseo.MetaTag{
+		property("og:type", "website"),
+		property("og:title", ws.Title),
+		property("og:url", ws.URL),
+		property("og:description", ws.Description),
+		property("og:image", ws.Image),
+		property("og:site_name", ws.SiteName),
+		property("og:locale", ws.Locale),
 	}
+
+	return nonEmpty(tags)
 }
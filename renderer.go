@@ -1,21 +1,37 @@
 package teseo
 
-import "github.com/a-h/templ"
+import (
+	"html/template"
+	"io"
+
+	"github.com/a-h/templ"
+)
 
 // TemplRenderer is the interface for rendering content as a templ component.
+//
+// Rendering never modifies the receiver: default values (e.g. `@context`, `@type`, `og:type`)
+// are applied to a copy while marshaling. A value can therefore be shared, e.g. as a package
+// level variable, and rendered concurrently from multiple goroutines.
 type TemplRenderer interface {
 	ToJsonLd() templ.Component
 	ToMetaTags() templ.Component
 }
 
-// HtmlRenderer is the interface for rendering content as an HTML string for Go's `template/html`.
+// HtmlRenderer is the interface for rendering content as `template.HTML` value for Go's `html/template`.
 type HtmlRenderer interface {
-	ToGoHTMLJsonLd() string
-	ToGoHTMLMetaTags() string
+	ToGoHTMLJsonLd() (template.HTML, error)
+	ToGoHTMLMetaTags() (template.HTML, error)
 }
 
-// SitemapRenderer is the interface for rendering content to and from sitemap files.
+// SitemapRenderer is the interface implemented by types written to and read from XML sitemaps, e.g. sitemap.Sitemap.
+// Both methods stream the content, so sitemaps are never held in memory as a whole XML document.
 type SitemapRenderer interface {
-	ToSitemapFile() string             // Convert the content to a sitemap file format (e.g., XML).
-	FromSitemapFile(data string) error // Load the content from a sitemap file.
+	Write(w io.Writer) error // Encode the content as XML sitemap to w.
+	Read(r io.Reader) error  // Decode the content from the XML sitemap read from r.
+}
+
+// WriterRenderer is the interface implemented by every Schema.org, Open Graph and Twitter Card type
+// rendering itself to an `io.Writer` without going through templ, e.g. for `html/template` only services.
+type WriterRenderer interface {
+	Render(w io.Writer) error
 }
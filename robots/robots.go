@@ -0,0 +1,351 @@
+// Package robots builds, serves and parses `robots.txt` files and tells whether a crawler may access
+// a path, following the Robots Exclusion Protocol (RFC 9309) as implemented by Google.
+// For more details see: https://developers.google.com/search/docs/crawling-indexing/robots/robots_txt
+package robots
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+)
+
+// Environment names of the presets returned by Presets.
+const (
+	Production  = "production"
+	Staging     = "staging"
+	Development = "development"
+)
+
+// Rule is an `Allow` or `Disallow` rule of a group.
+type Rule struct {
+	Allow bool   // Allow rule when true, Disallow rule otherwise
+	Path  string // Path pattern, e.g. "/admin/", "/*.pdf$"; an empty Disallow rule allows everything
+}
+
+// Allow returns an `Allow` rule for the path pattern.
+func Allow(path string) Rule {
+	return Rule{Allow: true, Path: path}
+}
+
+// Disallow returns a `Disallow` rule for the path pattern.
+func Disallow(path string) Rule {
+	return Rule{Path: path}
+}
+
+// Group is a set of rules applying to one or more crawlers.
+type Group struct {
+	UserAgents []string      // Product tokens of the crawlers, e.g. "Googlebot", or "*" for every crawler
+	Rules      []Rule        // Allow and Disallow rules, in rendering order
+	CrawlDelay time.Duration // Crawl-delay, ignored by Google but honoured by other crawlers
+}
+
+// File represents a `robots.txt` file.
+//
+// Example usage:
+//
+//	file := &robots.File{
+//		Groups: []robots.Group{
+//			{UserAgents: []string{"*"}, Rules: []robots.Rule{robots.Disallow("/admin/"), robots.Allow("/admin/public/")}},
+//			{UserAgents: []string{"GPTBot"}, Rules: []robots.Rule{robots.Disallow("/")}},
+//		},
+//		Sitemaps: []string{"https://www.example.com/sitemap.xml"},
+//	}
+//
+//	http.Handle("/robots.txt", file)
+//	file.Allowed("Googlebot", "/admin/settings") // false
+//
+// Expected output:
+//
+//	User-agent: *
+//	Disallow: /admin/
+//	Allow: /admin/public/
+//
+//	User-agent: GPTBot
+//	Disallow: /
+//
+//	Sitemap: https://www.example.com/sitemap.xml
+type File struct {
+	Groups   []Group  // Groups of rules
+	Sitemaps []string // Absolute URLs of the sitemaps
+}
+
+// AllowAll returns a File allowing every crawler to access everything and declaring the sitemaps.
+func AllowAll(sitemaps ...string) *File {
+	return &File{
+		Groups:   []Group{{UserAgents: []string{"*"}, Rules: []Rule{Disallow("")}}},
+		Sitemaps: sitemaps,
+	}
+}
+
+// DisallowAll returns a File disallowing every crawler to access anything, e.g. for a staging environment.
+func DisallowAll() *File {
+	return &File{Groups: []Group{{UserAgents: []string{"*"}, Rules: []Rule{Disallow("/")}}}}
+}
+
+// Render writes the `robots.txt` content of the File to w.
+func (f *File) Render(w io.Writer) error {
+	if _, err := io.WriteString(w, f.String()); err != nil {
+		return fmt.Errorf("failed to write robots.txt: %w", err)
+	}
+	return nil
+}
+
+// String returns the `robots.txt` content of the File.
+func (f *File) String() string {
+	var sb strings.Builder
+	for i, g := range f.Groups {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		for _, agent := range g.UserAgents {
+			writeLine(&sb, "User-agent", agent)
+		}
+		if len(g.Rules) == 0 {
+			writeLine(&sb, "Disallow", "")
+		}
+		for _, rule := range g.Rules {
+			if rule.Allow {
+				writeLine(&sb, "Allow", rule.Path)
+			} else {
+				writeLine(&sb, "Disallow", rule.Path)
+			}
+		}
+		if g.CrawlDelay > 0 {
+			writeLine(&sb, "Crawl-delay", strconv.FormatFloat(g.CrawlDelay.Seconds(), 'f', -1, 64))
+		}
+	}
+
+	if len(f.Sitemaps) > 0 {
+		if len(f.Groups) > 0 {
+			sb.WriteString("\n")
+		}
+		for _, sitemap := range f.Sitemaps {
+			writeLine(&sb, "Sitemap", sitemap)
+		}
+	}
+
+	return sb.String()
+}
+
+// writeLine writes a `name: value` line, without trailing space when value is empty.
+func writeLine(sb *strings.Builder, name, value string) {
+	sb.WriteString(name + ":")
+	if value != "" {
+		sb.WriteString(" " + value)
+	}
+	sb.WriteString("\n")
+}
+
+// ServeHTTP serves the `robots.txt` content of the File. It makes File an `http.Handler`.
+func (f *File) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if r.Method == http.MethodHead {
+		return
+	}
+	if err := f.Render(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Allowed reports whether the crawler userAgent may access path, e.g. "/search?q=go".
+//
+// The groups whose user-agent is the product token of the crawler apply, falling back to the `*` group;
+// groups with the same user-agent are merged. A user-agent must equal the product token, compared
+// case-insensitively: a "Googlebot" group does not apply to "Googlebot-Image". Within the group the longest matching rule wins and
+// Allow wins over Disallow on ties. `*` matches any sequence of characters and `$` anchors the end of the path.
+// userAgent can be a product token ("Googlebot") or a full User-Agent header value.
+func (f *File) Allowed(userAgent, path string) bool {
+	if path == "" {
+		path = "/"
+	}
+	if path == "/robots.txt" {
+		return true
+	}
+
+	allowed, length := true, -1
+	for _, rule := range f.rules(productToken(userAgent)) {
+		if rule.Path == "" || !match(rule.Path, path) {
+			continue
+		}
+		n := len(rule.Path)
+		if n > length || (n == length && rule.Allow) {
+			allowed, length = rule.Allow, n
+		}
+	}
+	return allowed
+}
+
+// rules returns the rules of the groups whose user-agent is the crawler token, compared case-insensitively,
+// or the rules of the `*` groups when there is none.
+func (f *File) rules(token string) []Rule {
+	var result, fallback []Rule
+	for _, g := range f.Groups {
+		matched, wildcard := false, false
+		for _, agent := range g.UserAgents {
+			agent = strings.TrimSpace(agent)
+			matched = matched || strings.EqualFold(agent, token)
+			wildcard = wildcard || agent == "*"
+		}
+		if matched {
+			result = append(result, g.Rules...)
+		}
+		if wildcard {
+			fallback = append(fallback, g.Rules...)
+		}
+	}
+	if result == nil {
+		return fallback
+	}
+	return result
+}
+
+// Validate checks the groups and the sitemaps of the File.
+// Disallowing everything to every crawler is reported as warning, it is rarely wanted in production.
+func (f *File) Validate() []teseo.Issue {
+	v := validate.New("robots.File")
+	for i, g := range f.Groups {
+		gv := v.Index("groups", i)
+		gv.Required("userAgents", len(g.UserAgents) > 0)
+		wildcard := false
+		for _, agent := range g.UserAgents {
+			wildcard = wildcard || strings.TrimSpace(agent) == "*"
+		}
+
+		for j, rule := range g.Rules {
+			if rule.Path != "" && !strings.HasPrefix(rule.Path, "/") && !strings.HasPrefix(rule.Path, "*") {
+				gv.AddIndex(teseo.SeverityError, "rules", j, "path", fmt.Sprintf("rule path %q must start with / or *", rule.Path))
+			}
+			if wildcard && !rule.Allow && rule.Path == "/" {
+				gv.AddIndex(teseo.SeverityWarning, "rules", j, "disallowAll", "every crawler is disallowed to access the whole site")
+			}
+		}
+	}
+
+	v.URLs("sitemaps", f.Sitemaps)
+	return v.Issues()
+}
+
+// Parse reads a `robots.txt` file. Comments, unknown fields and rules outside of a group are ignored.
+func Parse(r io.Reader) (*File, error) {
+	f := &File{}
+	var group *Group
+	inRules := false
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 0; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if lineNo == 0 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		line, _, _ = strings.Cut(line, "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "user-agent":
+			if group == nil || inRules {
+				f.Groups = append(f.Groups, Group{})
+				group = &f.Groups[len(f.Groups)-1]
+				inRules = false
+			}
+			group.UserAgents = append(group.UserAgents, value)
+		case "allow", "disallow":
+			if group == nil {
+				continue
+			}
+			group.Rules = append(group.Rules, Rule{Allow: strings.EqualFold(strings.TrimSpace(key), "allow"), Path: value})
+			inRules = true
+		case "crawl-delay":
+			if group == nil {
+				continue
+			}
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				group.CrawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+			inRules = true
+		case "sitemap":
+			f.Sitemaps = append(f.Sitemaps, value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read robots.txt: %w", err)
+	}
+	return f, nil
+}
+
+// Presets returns the files of the usual environments: production serves the provided file,
+// staging and development disallow everything.
+func Presets(production *File) map[string]*File {
+	return map[string]*File{
+		Production:  production,
+		Staging:     DisallowAll(),
+		Development: DisallowAll(),
+	}
+}
+
+// Handler returns an `http.Handler` serving the file of the environment env, e.g. read from an
+// environment variable. Unknown environments serve DisallowAll, so a misconfigured deployment
+// is never indexed.
+//
+// Example usage:
+//
+//	files := robots.Presets(robots.AllowAll("https://www.example.com/sitemap.xml"))
+//	http.Handle("/robots.txt", robots.Handler(os.Getenv("APP_ENV"), files))
+func Handler(env string, files map[string]*File) http.Handler {
+	if f, ok := files[env]; ok && f != nil {
+		return f
+	}
+	return DisallowAll()
+}
+
+// productToken returns the lowercase product token of a User-Agent value, e.g. "googlebot" for
+// "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)".
+func productToken(userAgent string) string {
+	userAgent = strings.ToLower(strings.TrimSpace(userAgent))
+	if start := strings.Index(userAgent, "compatible;"); start >= 0 {
+		userAgent = strings.TrimSpace(userAgent[start+len("compatible;"):])
+	}
+	token, _, _ := strings.Cut(userAgent, "/")
+	token, _, _ = strings.Cut(token, " ")
+	return strings.TrimSuffix(token, ";")
+}
+
+// match reports whether path matches the rule pattern, where `*` matches any sequence
+// of characters and a trailing `$` anchors the end of the path.
+func match(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	rest := path[len(parts[0]):]
+	if len(parts) == 1 {
+		return !anchored || rest == ""
+	}
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(rest, part)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(part):]
+	}
+
+	last := parts[len(parts)-1]
+	if anchored {
+		return strings.HasSuffix(rest, last)
+	}
+	return strings.Contains(rest, last)
+}
@@ -0,0 +1,153 @@
+package robots
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const robotsTxt = `# Example robots.txt
+User-agent: *
+Disallow: /admin/
+Allow: /admin/public/
+Disallow: /*.pdf$
+Crawl-delay: 1.5
+
+User-agent: Googlebot
+User-agent: Bingbot
+Disallow: /search
+Allow: /search/about
+
+User-agent: GPTBot
+Disallow: /
+
+Sitemap: https://www.example.com/sitemap.xml
+`
+
+// TestParse tests that a parsed file renders back to the same content
+func TestParse(t *testing.T) {
+	f, err := Parse(strings.NewReader(robotsTxt))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(f.Groups) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(f.Groups))
+	}
+	if !reflect.DeepEqual(f.Groups[1].UserAgents, []string{"Googlebot", "Bingbot"}) {
+		t.Errorf("unexpected user agents %v", f.Groups[1].UserAgents)
+	}
+	if f.Groups[0].CrawlDelay != 1500*time.Millisecond {
+		t.Errorf("unexpected crawl delay %v", f.Groups[0].CrawlDelay)
+	}
+
+	expected := strings.TrimPrefix(robotsTxt, "# Example robots.txt\n")
+	if got := f.String(); got != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, got)
+	}
+}
+
+// TestAllowed tests the group selection and the longest match rule
+func TestAllowed(t *testing.T) {
+	f, err := Parse(strings.NewReader(robotsTxt))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		userAgent string
+		path      string
+		expected  bool
+	}{
+		{"*", "/", true},
+		{"SomeBot", "/admin/settings", false},
+		{"SomeBot", "/admin/public/logo.png", true},
+		{"SomeBot", "/files/report.pdf", false},
+		{"SomeBot", "/files/report.pdf?download=1", true},
+		{"Googlebot", "/admin/settings", true},
+		{"Googlebot", "/search?q=go", false},
+		{"Googlebot", "/search/about", true},
+		{"Googlebot-Image", "/search", true},
+		{"Googlebot-Image", "/admin/settings", false},
+		{"GPTBot-Extended", "/", true},
+		{"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)", "/search", false},
+		{"GPTBot", "/", false},
+		{"GPTBot", "/robots.txt", true},
+	}
+
+	for _, tt := range tests {
+		if got := f.Allowed(tt.userAgent, tt.path); got != tt.expected {
+			t.Errorf("Allowed(%q, %q): expected %v, got %v", tt.userAgent, tt.path, tt.expected, got)
+		}
+	}
+}
+
+// TestMatch tests the wildcard and the end anchor of the path patterns
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"/fish", "/fish.html", true},
+		{"/fish", "/Fish.asp", false},
+		{"/fish*", "/fishheads/yummy.html", true},
+		{"/*.php", "/folder/filename.php?parameters", true},
+		{"/*.php$", "/filename.php?parameters", false},
+		{"/*.php$", "/folder/filename.php", true},
+		{"/fish*.php", "/fishheads/catfish.php?parameters", true},
+		{"/fish*.php", "/Fish.PHP", false},
+	}
+
+	for _, tt := range tests {
+		if got := match(tt.pattern, tt.path); got != tt.expected {
+			t.Errorf("match(%q, %q): expected %v, got %v", tt.pattern, tt.path, tt.expected, got)
+		}
+	}
+}
+
+// TestHandler tests that unknown environments are never indexed
+func TestHandler(t *testing.T) {
+	files := Presets(AllowAll("https://www.example.com/sitemap.xml"))
+
+	tests := map[string]string{
+		Production: "User-agent: *\nDisallow:\n\nSitemap: https://www.example.com/sitemap.xml\n",
+		Staging:    "User-agent: *\nDisallow: /\n",
+		"":         "User-agent: *\nDisallow: /\n",
+	}
+	for env, expected := range tests {
+		rec := httptest.NewRecorder()
+		Handler(env, files).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/robots.txt", nil))
+		if rec.Body.String() != expected {
+			t.Errorf("%q: expected\n%s\ngot\n%s", env, expected, rec.Body.String())
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+			t.Errorf("%q: unexpected content type %q", env, ct)
+		}
+	}
+}
+
+// TestValidate tests the rule paths, the sitemaps and the disallow all warning
+func TestValidate(t *testing.T) {
+	f := &File{
+		Groups:   []Group{{UserAgents: []string{"*"}, Rules: []Rule{Disallow("admin"), Disallow("/")}}, {Rules: []Rule{Allow("/")}}},
+		Sitemaps: []string{"/sitemap.xml"},
+	}
+
+	var rules []string
+	for _, issue := range f.Validate() {
+		rules = append(rules, issue.Rule+" "+issue.Field)
+	}
+	expected := []string{
+		"robots.File.groups.rules.path groups[0].rules[0]",
+		"robots.File.groups.rules.disallowAll groups[0].rules[1]",
+		"robots.File.groups.userAgents.required groups[1].userAgents",
+		"robots.File.sitemaps.url sitemaps[0]",
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("expected %v, got %v", expected, rules)
+	}
+}
@@ -1,13 +1,12 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Article represents a Schema.org Article object.
@@ -63,7 +62,8 @@ import (
 //		"description": "This is an example article"
 //	}
 type Article struct {
-	Context       string        `json:"@context"`
+	Context       string        `json:"@context,omitempty"`
+	ID            string        `json:"@id,omitempty"`
 	Type          string        `json:"@type"`
 	Headline      string        `json:"headline,omitempty"`
 	Image         []string      `json:"image,omitempty"`
@@ -91,39 +91,66 @@ func NewArticle(headline string, images []string, author *Person, publisher *Org
 
 // ToJsonLd converts the Article struct to a JSON-LD `templ.Component`.
 func (art *Article) ToJsonLd() templ.Component {
-	art.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "article", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, art).WithType("application/ld+json")
+	return newDocument("article", art.Context, art).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the Article struct as `template.HTML` value for Go's `html/template`.
 func (art *Article) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := art.ToJsonLd()
-
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+	return teseo.RenderGoHTML("schemaorg.Article", art.ToJsonLd())
+}
 
-	return html, nil
+// Render writes the Article JSON-LD script to w, without going through templ.
+func (art *Article) Render(w io.Writer) error {
+	return newDocument("article", art.Context, art).Render(w)
 }
 
-func (art *Article) ensureDefaults() {
-	if art.Context == "" {
-		art.Context = "https://schema.org"
+// MergeSite fills the empty publisher and image with the Site defaults.
+func (art *Article) MergeSite(site *teseo.Site) {
+	if site == nil {
+		return
 	}
-
-	if art.Type == "" {
-		art.Type = "Article"
+	if art.Publisher == nil {
+		art.Publisher = newPublisher(site.Publisher)
+	}
+	if len(art.Image) == 0 && site.Image != "" {
+		art.Image = []string{site.Image}
 	}
+}
+
+// Validate checks the Article against the Google rich results guidelines for articles.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/article
+func (art *Article) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.Article")
+	v.Recommended("headline", validate.NotEmpty(art.Headline))
+	v.Recommended("image", len(art.Image) > 0)
+	v.Recommended("author", art.Author != nil)
+	v.Recommended("datePublished", art.DatePublished != "")
+	v.Recommended("dateModified", art.DateModified != "")
+	v.URLs("image", art.Image)
+	v.Date("datePublished", art.DatePublished)
+	v.Date("dateModified", art.DateModified)
 
 	if art.Author != nil {
-		art.Author.ensureDefaults()
+		art.Author.validate(v.Child("author"))
 	}
 
 	if art.Publisher != nil {
-		art.Publisher.ensureDefaults()
+		art.Publisher.validate(v.Child("publisher"))
 	}
+
+	return v.Issues()
+}
+
+func (art *Article) ensureDefaults() {
+	if art.Type == "" {
+		art.Type = "Article"
+	}
+}
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Article.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (art Article) MarshalJSON() ([]byte, error) {
+	type alias Article
+	art.ensureDefaults()
+	return marshalEntity(alias(art))
 }
@@ -1,16 +1,16 @@
 package schemaorg
 
 import (
-	"context"
 	"fmt"
 	"html/template"
-	"log"
+	"io"
 	"net/url"
 	"strings"
 	"unicode"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // BreadcrumbList represents a Schema.org BreadcrumbList object.
@@ -39,7 +39,7 @@ import (
 // Example usage with `NewBreadcrumbListFromUrl`:
 //
 //	func HandleAbout(w http.ResponseWriter, r *http.Request) {
-//		pageURL := teseo.GetFullURL(r)
+//		pageURL := resolver.Resolve(r) // resolver is a *teseo.URLResolver
 //		breadcrumbList, err := schemaorg.NewBreadcrumbListFromUrl(pageURL)
 //		if err != nil {
 //			fmt.Println("Error generating breadcrumb list:", err)
@@ -74,7 +74,8 @@ import (
 //		]
 //	}
 type BreadcrumbList struct {
-	Context         string     `json:"@context"`
+	Context         string     `json:"@context,omitempty"`
+	ID              string     `json:"@id,omitempty"`
 	Type            string     `json:"@type"`
 	ItemListElement []ListItem `json:"itemListElement"`
 }
@@ -99,38 +100,51 @@ func NewBreadcrumbListFromUrl(url string) (*BreadcrumbList, error) {
 
 // ToJsonLd converts the BreadcrumbList struct to a JSON-LD `templ.Component`.
 func (bcl *BreadcrumbList) ToJsonLd() templ.Component {
-	bcl.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "breadcrumbList", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, bcl).WithType("application/ld+json")
+	return newDocument("breadcrumbList", bcl.Context, bcl).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the BreadcrumbList struct as `template.HTML` value for Go's `html/template`.
 func (bcl *BreadcrumbList) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := bcl.ToJsonLd()
+	return teseo.RenderGoHTML("schemaorg.BreadcrumbList", bcl.ToJsonLd())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
+// Render writes the BreadcrumbList JSON-LD script to w, without going through templ.
+func (bcl *BreadcrumbList) Render(w io.Writer) error {
+	return newDocument("breadcrumbList", bcl.Context, bcl).Render(w)
+}
+
+// Validate checks the BreadcrumbList against the Google rich results guidelines for breadcrumbs.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/breadcrumb
+func (bcl *BreadcrumbList) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.BreadcrumbList")
+	v.Required("itemListElement", len(bcl.ItemListElement) > 0)
+
+	for i, item := range bcl.ItemListElement {
+		iv := v.Index("itemListElement", i)
+		iv.Required("position", item.Position > 0)
+		iv.Required("name", validate.NotEmpty(item.Name))
+		// The item URL is optional for the last breadcrumb only.
+		if i < len(bcl.ItemListElement)-1 {
+			iv.Required("item", item.Item != "")
+		}
+		iv.URL("item", item.Item)
 	}
 
-	return html, nil
+	return v.Issues()
 }
 
 func (bcl *BreadcrumbList) ensureDefaults() {
-	if bcl.Context == "" {
-		bcl.Context = "https://schema.org"
-	}
-
 	if bcl.Type == "" {
 		bcl.Type = "BreadcrumbList"
 	}
+}
 
-	// Loop over each ListItem in ile and set its Type to "ListItem"
-	for i := range bcl.ItemListElement {
-		bcl.ItemListElement[i].Type = "ListItem"
-	}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the BreadcrumbList.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (bcl BreadcrumbList) MarshalJSON() ([]byte, error) {
+	type alias BreadcrumbList
+	bcl.ensureDefaults()
+	return marshalEntity(alias(bcl))
 }
 
 // createBreadcrumbListFromURL generates a BreadcrumbList JSON-LD object from a URL string.
@@ -0,0 +1,221 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// DefaultContext is the @context of a Document when none is set.
+const DefaultContext = "https://schema.org"
+
+// Document represents a JSON-LD document holding a root entity.
+// The @context is emitted once, on the root entity: nested entities, e.g. the publisher
+// of an Article or the address of a Person, never emit it. The same applies when an entity
+// is encoded with json.Marshal, its @context being DefaultContext when its Context field is empty.
+//
+// Every entity renders itself through a Document, using its own Context field when set.
+// Create a Document explicitly to supply a custom context, e.g. with extra vocabularies.
+//
+// Example usage:
+//
+//	doc := schemaorg.NewDocument([]any{
+//		"https://schema.org",
+//		map[string]string{"ex": "https://www.example.com/vocab#"},
+//	}, product)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@doc.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml, err := doc.ToGoHTMLJsonLd()
+type Document struct {
+	Context any    // Value of @context, e.g. a string, a slice or a map; DefaultContext when nil
+	Entity  Entity // Root entity
+	prefix  string
+}
+
+// NewDocument initializes a Document with the provided context and root entity.
+func NewDocument(context any, entity Entity) *Document {
+	return &Document{Context: context, Entity: entity}
+}
+
+// newDocument returns the Document rendering entity with the given id prefix.
+// A non-empty context is the Context field of the entity.
+func newDocument(prefix, context string, entity Entity) *Document {
+	doc := &Document{Entity: entity, prefix: prefix}
+	if context != "" {
+		doc.Context = context
+	}
+	return doc
+}
+
+// ToJsonLd converts the Document to a JSON-LD `templ.Component`.
+func (d *Document) ToJsonLd() templ.Component {
+	return teseo.JsonLdScript(d.idPrefix(), d)
+}
+
+// ToGoHTMLJsonLd renders the Document as `template.HTML` value for Go's `html/template`.
+func (d *Document) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderGoHTML("schemaorg.Document", d.ToJsonLd())
+}
+
+// Render writes the Document JSON-LD script to w, without going through templ.
+func (d *Document) Render(w io.Writer) error {
+	return teseo.WriteJsonLd(w, d.idPrefix(), d)
+}
+
+// idPrefix returns the prefix of the script id, "document" when none is set.
+func (d *Document) idPrefix() string {
+	if d.prefix == "" {
+		return "document"
+	}
+	return d.prefix
+}
+
+// MergeSite replaces the root entity with a copy whose empty fields are filled with the Site defaults.
+// The entity itself is left unchanged, as it may be shared with other documents.
+func (d *Document) MergeSite(site *teseo.Site) {
+	if entity, ok := site.Merged(d.Entity).(Entity); ok {
+		d.Entity = entity
+	}
+}
+
+// Validate checks the properties of the root entity.
+func (d *Document) Validate() []teseo.Issue {
+	if d.Entity == nil {
+		return nil
+	}
+	return d.Entity.Validate()
+}
+
+// MarshalJSON implements json.Marshaler, emitting @context as the first property of the root entity.
+func (d Document) MarshalJSON() ([]byte, error) {
+	if d.Entity == nil {
+		return nil, fmt.Errorf("schemaorg: document has no entity")
+	}
+
+	context := d.Context
+	if context == nil {
+		context = DefaultContext
+	}
+	contextJSON, err := json.Marshal(context)
+	if err != nil {
+		return nil, err
+	}
+
+	entityJSON, err := json.Marshal(d.Entity)
+	if err != nil {
+		return nil, err
+	}
+	if entityJSON, err = withoutContexts(entityJSON, 1); err != nil {
+		return nil, err
+	}
+	if len(entityJSON) < 2 || entityJSON[0] != '{' {
+		return nil, fmt.Errorf("schemaorg: document entity %T is not a JSON object", d.Entity)
+	}
+
+	data := make([]byte, 0, len(contextJSON)+len(entityJSON)+14)
+	data = append(data, `{"@context":`...)
+	data = append(data, contextJSON...)
+	if len(entityJSON) > 2 {
+		data = append(data, ',')
+	}
+	return append(data, entityJSON[1:]...), nil
+}
+
+// marshalEntity encodes the root entity v with its @context, DefaultContext when it has none,
+// and without the @context of the entities nested in it.
+func marshalEntity(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = withoutContexts(data, 2); err != nil {
+		return nil, err
+	}
+	return withContext(data, json.RawMessage(strconv.Quote(DefaultContext))), nil
+}
+
+// withoutContexts returns the JSON value data without the @context properties of the objects
+// at the given depth or deeper, the root value being at depth 1.
+func withoutContexts(data []byte, depth int) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	if err := copyValue(&buf, dec, 1, depth); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// copyValue copies the next value of dec, at the given level, to buf, dropping the @context
+// properties of the objects at depth or deeper.
+func copyValue(buf *bytes.Buffer, dec *json.Decoder, level, depth int) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		data, err := json.Marshal(tok)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		return nil
+	}
+
+	buf.WriteByte(byte(delim))
+	first := true
+	for dec.More() {
+		target := buf
+		if delim == '{' {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := tok.(string)
+			if key == "@context" && level >= depth {
+				target = &bytes.Buffer{}
+			} else {
+				if !first {
+					buf.WriteByte(',')
+				}
+				data, err := json.Marshal(key)
+				if err != nil {
+					return err
+				}
+				buf.Write(data)
+				buf.WriteByte(':')
+				first = false
+			}
+		} else {
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+		}
+		if err := copyValue(target, dec, level+1, depth); err != nil {
+			return err
+		}
+	}
+
+	tok, err = dec.Token()
+	if err != nil {
+		return err
+	}
+	buf.WriteByte(byte(tok.(json.Delim)))
+	return nil
+}
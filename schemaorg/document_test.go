@@ -0,0 +1,77 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestDocumentContext tests that @context is emitted once, on the root entity only
+func TestDocumentContext(t *testing.T) {
+	publisher := NewOrganization("Example Publisher", "https://www.example.com", "https://www.example.com/logo.png", nil, nil)
+	publisher.Context = "https://schema.org"
+	article := &Article{
+		Headline:  "Example Article",
+		Author:    &Person{Context: "https://schema.org", Name: "Jane Doe", WorksFor: publisher},
+		Publisher: publisher,
+	}
+
+	tests := []struct {
+		name    string
+		doc     *Document
+		context string
+	}{
+		{"entity", newDocument("article", article.Context, article), `"https://schema.org"`},
+		{"custom", NewDocument([]any{"https://schema.org", map[string]string{"ex": "https://www.example.com/vocab#"}}, article),
+			`["https://schema.org",{"ex":"https://www.example.com/vocab#"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if !strings.HasPrefix(string(data), `{"@context":`+tt.context+`,"@type":"Article"`) {
+				t.Errorf("expected @context first on the root entity, got %s", data)
+			}
+			if n := strings.Count(string(data), "@context"); n != 1 {
+				t.Errorf("expected a single @context, got %d in %s", n, data)
+			}
+		})
+	}
+
+	html, err := article.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Fatalf("ToGoHTMLJsonLd failed: %v", err)
+	}
+	if n := strings.Count(string(html), "@context"); n != 1 {
+		t.Errorf("expected a single @context, got %d in %s", n, html)
+	}
+}
+
+// TestMarshalEntityContext tests that an entity encoded with json.Marshal emits @context on the root only
+func TestMarshalEntityContext(t *testing.T) {
+	data, err := json.Marshal(&Organization{Name: "x"})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if expected := `{"@context":"https://schema.org","@type":"Organization","name":"x"}`; string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	article := &Article{
+		Context:   "https://schema.org/",
+		Headline:  "Example Article",
+		Publisher: &Organization{Context: "https://schema.org", Name: "Example Publisher"},
+	}
+	if data, err = json.Marshal(article); err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if !strings.HasPrefix(string(data), `{"@context":"https://schema.org/",`) {
+		t.Errorf("expected the @context of the root entity, got %s", data)
+	}
+	if n := strings.Count(string(data), "@context"); n != 1 {
+		t.Errorf("expected a single @context, got %d in %s", n, data)
+	}
+}
@@ -1,13 +1,12 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Event represents a Schema.org Event object.
@@ -57,7 +56,8 @@ import (
 //		"description": "This is an example event"
 //	}
 type Event struct {
-	Context             string        `json:"@context"`
+	Context             string        `json:"@context,omitempty"`
+	ID                  string        `json:"@id,omitempty"`
 	Type                string        `json:"@type"`
 	Name                string        `json:"name,omitempty"`
 	Description         string        `json:"description,omitempty"`
@@ -74,7 +74,7 @@ type Event struct {
 
 // Place represents a Schema.org Place object
 type Place struct {
-	Context string          `json:"@context"`
+	Context string          `json:"@context,omitempty"`
 	Type    string          `json:"@type"`
 	Name    string          `json:"name,omitempty"`
 	Address *PostalAddress  `json:"address,omitempty"`
@@ -102,66 +102,96 @@ func NewEvent(name, description, startDate, endDate string, location *Place, org
 
 // ToJsonLd converts the Event struct to a JSON-LD `templ.Component`.
 func (e *Event) ToJsonLd() templ.Component {
-	e.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "event", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, e).WithType("application/ld+json")
+	return newDocument("event", e.Context, e).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the Event struct as `template.HTML` value for Go's `html/template`.
 func (e *Event) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := e.ToJsonLd()
-
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
-
-	return html, nil
+	return teseo.RenderGoHTML("schemaorg.Event", e.ToJsonLd())
 }
 
-// ensureDefaults sets default values for Event and its nested objects if they are not already set.
-func (e *Event) ensureDefaults() {
-	if e.Context == "" {
-		e.Context = "https://schema.org"
-	}
+// Render writes the Event JSON-LD script to w, without going through templ.
+func (e *Event) Render(w io.Writer) error {
+	return newDocument("event", e.Context, e).Render(w)
+}
 
-	if e.Type == "" {
-		e.Type = "Event"
-	}
+// Validate checks the Event against the Google rich results guidelines for events.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/event
+func (e *Event) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.Event")
+	v.Required("name", validate.NotEmpty(e.Name))
+	v.Required("startDate", e.StartDate != "")
+	v.Required("location", e.Location != nil)
+	v.Recommended("description", validate.NotEmpty(e.Description))
+	v.Recommended("endDate", e.EndDate != "")
+	v.Recommended("eventStatus", e.EventStatus != "")
+	v.Recommended("image", len(e.Image) > 0)
+	v.Recommended("offers", e.Offers != nil)
+	v.Recommended("organizer", e.Organizer != nil)
+	v.Recommended("performer", e.Performer != nil)
+	v.Date("startDate", e.StartDate)
+	v.Date("endDate", e.EndDate)
+	v.URLs("image", e.Image)
 
 	if e.Location != nil {
-		e.Location.ensureDefaults()
+		e.Location.validate(v.Child("location"))
 	}
 
 	if e.Organizer != nil {
-		e.Organizer.ensureDefaults()
+		e.Organizer.validate(v.Child("organizer"))
 	}
 
 	if e.Performer != nil {
-		e.Performer.ensureDefaults()
+		e.Performer.validate(v.Child("performer"))
 	}
 
 	if e.Offers != nil {
-		e.Offers.ensureDefaults()
+		e.Offers.validate(v.Child("offers"))
 	}
+
+	return v.Issues()
+}
+
+// ensureDefaults sets default values for Event and its nested objects if they are not already set.
+func (e *Event) ensureDefaults() {
+	if e.Type == "" {
+		e.Type = "Event"
+	}
+}
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Event.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (e Event) MarshalJSON() ([]byte, error) {
+	type alias Event
+	e.ensureDefaults()
+	return marshalEntity(alias(e))
 }
 
 // ensureDefaults sets default values for Place if they are not already set.
 func (p *Place) ensureDefaults() {
-	if p.Context == "" {
-		p.Context = "https://schema.org"
-	}
 	if p.Type == "" {
 		p.Type = "Place"
 	}
+}
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Place.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (p Place) MarshalJSON() ([]byte, error) {
+	type alias Place
+	p.ensureDefaults()
+	return marshalEntity(alias(p))
+}
+
+// validate checks the Place properties.
+func (p *Place) validate(v *validate.Validator) {
+	v.Recommended("name", validate.NotEmpty(p.Name))
+	v.Required("address", p.Address != nil)
 
 	if p.Address != nil {
-		p.Address.ensureDefaults()
+		p.Address.validate(v.Child("address"))
 	}
 
 	if p.Geo != nil {
-		p.Geo.ensureDefaults()
+		p.Geo.validate(v.Child("geo"))
 	}
 }
@@ -1,13 +1,13 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
+	"encoding/json"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // FAQPage represents a Schema.org FAQPage object.
@@ -55,7 +55,8 @@ import (
 //		]
 //	}
 type FAQPage struct {
-	Context    string      `json:"@context"`
+	Context    string      `json:"@context,omitempty"`
+	ID         string      `json:"@id,omitempty"`
 	Type       string      `json:"@type"`
 	MainEntity []*Question `json:"mainEntity,omitempty"`
 }
@@ -104,48 +105,66 @@ func NewAnswer(text string) *Answer {
 
 // ToJsonLd converts the FAQPage struct to a JSON-LD `templ.Component`.
 func (fp *FAQPage) ToJsonLd() templ.Component {
-	fp.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "faqpage", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, fp).WithType("application/ld+json")
+	return newDocument("faqpage", fp.Context, fp).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the FAQPage struct as`template.HTML` value for Go's `html/template`.
 func (fp *FAQPage) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := fp.ToJsonLd()
+	return teseo.RenderGoHTML("schemaorg.FAQPage", fp.ToJsonLd())
+}
+
+// Render writes the FAQPage JSON-LD script to w, without going through templ.
+func (fp *FAQPage) Render(w io.Writer) error {
+	return newDocument("faqpage", fp.Context, fp).Render(w)
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
+// Validate checks the FAQPage against the Google rich results guidelines for FAQs.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/faqpage
+func (fp *FAQPage) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.FAQPage")
+	v.Required("mainEntity", len(fp.MainEntity) > 0)
+
+	for i, q := range fp.MainEntity {
+		if q == nil {
+			continue
+		}
+		qv := v.Index("mainEntity", i)
+		qv.Required("name", validate.NotEmpty(q.Name))
+		qv.Required("acceptedAnswer", q.AcceptedAnswer != nil)
+		if q.AcceptedAnswer != nil {
+			qv.Child("acceptedAnswer").Required("text", validate.NotEmpty(q.AcceptedAnswer.Text))
+		}
 	}
 
-	return html, nil
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for FAQPage, Question, and Answer if they are not already set.
 func (fp *FAQPage) ensureDefaults() {
-	if fp.Context == "" {
-		fp.Context = "https://schema.org"
-	}
-
 	if fp.Type == "" {
 		fp.Type = "FAQPage"
 	}
+}
 
-	for _, q := range fp.MainEntity {
-		q.ensureDefaults()
-	}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the FAQPage.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (fp FAQPage) MarshalJSON() ([]byte, error) {
+	type alias FAQPage
+	fp.ensureDefaults()
+	return marshalEntity(alias(fp))
 }
 
 func (q *Question) ensureDefaults() {
 	if q.Type == "" {
 		q.Type = "Question"
 	}
+}
 
-	if q.AcceptedAnswer != nil {
-		q.AcceptedAnswer.ensureDefaults()
-	}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Question.
+func (q Question) MarshalJSON() ([]byte, error) {
+	type alias Question
+	q.ensureDefaults()
+	return json.Marshal(alias(q))
 }
 
 func (a *Answer) ensureDefaults() {
@@ -153,3 +172,10 @@ func (a *Answer) ensureDefaults() {
 		a.Type = "Answer"
 	}
 }
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Answer.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	type alias Answer
+	a.ensureDefaults()
+	return json.Marshal(alias(a))
+}
@@ -0,0 +1,308 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// Graph represents a JSON-LD document holding several entities in a single `@graph`,
+// linked to each other by `@id` as recommended by Google.
+//
+// Every entity gets a stable @id: its ID field when set, otherwise the BaseURL followed by
+// a fragment derived from its type, e.g. "https://www.example.com/#organization".
+// Nested entities (e.g. the publisher of an Article) are replaced by an `{"@id": ...}` reference
+// when they match an entity of the graph, or when the same entity is nested more than once,
+// in which case it is moved to the graph. Entities match when they have the same @id or,
+// without @id, the same @type and url, or, when neither has a url, the same @type and name.
+//
+// Example usage:
+//
+//	org := &schemaorg.Organization{Name: "Example", URL: "https://www.example.com"}
+//
+//	graph := schemaorg.NewGraph("https://www.example.com/",
+//		&schemaorg.WebSite{Name: "Example", URL: "https://www.example.com"},
+//		org,
+//		&schemaorg.Article{Headline: "Example Article", Publisher: org},
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@graph.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml, err := graph.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@graph": [
+//			{"@id": "https://www.example.com/#website", "@type": "WebSite", "name": "Example", "url": "https://www.example.com"},
+//			{"@id": "https://www.example.com/#organization", "@type": "Organization", "name": "Example", "url": "https://www.example.com"},
+//			{"@id": "https://www.example.com/#article", "@type": "Article", "headline": "Example Article", "publisher": {"@id": "https://www.example.com/#organization"}}
+//		]
+//	}
+type Graph struct {
+	Context  any      // Value of @context, e.g. a string, a slice or a map; DefaultContext when nil
+	BaseURL  string   // Prefix of the generated @id values, e.g. "https://www.example.com/"
+	Entities []Entity // Entities of the graph, rendered in order
+}
+
+// NewGraph initializes a Graph with the provided base URL and entities.
+func NewGraph(baseURL string, entities ...Entity) *Graph {
+	return (&Graph{BaseURL: baseURL}).Add(entities...)
+}
+
+// Add appends entities to the Graph. Nil entities are ignored.
+func (g *Graph) Add(entities ...Entity) *Graph {
+	for _, entity := range entities {
+		if entity == nil {
+			continue
+		}
+		g.Entities = append(g.Entities, entity)
+	}
+	return g
+}
+
+// ToJsonLd converts the Graph to a JSON-LD `templ.Component`.
+func (g *Graph) ToJsonLd() templ.Component {
+	return teseo.JsonLdScript("graph", g)
+}
+
+// ToGoHTMLJsonLd renders the Graph as `template.HTML` value for Go's `html/template`.
+func (g *Graph) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderGoHTML("schemaorg.Graph", g.ToJsonLd())
+}
+
+// Render writes the Graph JSON-LD script to w, without going through templ.
+func (g *Graph) Render(w io.Writer) error {
+	return teseo.WriteJsonLd(w, "graph", g)
+}
+
+// MergeSite replaces the entities of the Graph with copies whose empty fields are filled with the Site defaults.
+// The entities themselves are left unchanged, as they may be shared with other graphs.
+func (g *Graph) MergeSite(site *teseo.Site) {
+	entities := make([]Entity, len(g.Entities))
+	for i, entity := range g.Entities {
+		entities[i], _ = site.Merged(entity).(Entity)
+	}
+	g.Entities = entities
+}
+
+// Validate checks the properties of every entity of the Graph.
+func (g *Graph) Validate() []teseo.Issue {
+	var issues []teseo.Issue
+	for _, entity := range g.Entities {
+		issues = append(issues, entity.Validate()...)
+	}
+	return issues
+}
+
+// MarshalJSON implements json.Marshaler, emitting the entities as a `@graph` linked by @id.
+func (g Graph) MarshalJSON() ([]byte, error) {
+	b := &graphBuilder{baseURL: g.BaseURL, ids: map[string]string{}, taken: map[string]bool{}, counts: map[string]int{}}
+
+	for _, entity := range g.Entities {
+		node, err := decodeNode(entity)
+		if err != nil {
+			return nil, err
+		}
+		b.nodes = append(b.nodes, node)
+	}
+
+	for _, node := range b.nodes {
+		b.register(node)
+	}
+	for _, node := range b.nodes {
+		b.count(node, true)
+	}
+	// Hoisted entities are appended to b.nodes while linking and linked in turn.
+	for i := 0; i < len(b.nodes); i++ {
+		b.link(b.nodes[i])
+	}
+
+	context := g.Context
+	if context == nil {
+		context = DefaultContext
+	}
+	return json.Marshal(struct {
+		Context any              `json:"@context"`
+		Graph   []map[string]any `json:"@graph"`
+	}{context, b.nodes})
+}
+
+// graphBuilder assigns the @id values and replaces nested entities by references.
+type graphBuilder struct {
+	baseURL string
+	nodes   []map[string]any
+	ids     map[string]string // identity key to @id
+	taken   map[string]bool   // @id values in use
+	counts  map[string]int    // occurrences of nested entities by identity key
+}
+
+// register assigns an @id to a node of the graph and indexes its identity keys.
+func (b *graphBuilder) register(node map[string]any) string {
+	keys := nodeKeys(node)
+	id, _ := node["@id"].(string)
+	if id == "" {
+		id = b.newID(nodeType(node))
+		node["@id"] = id
+	}
+	b.taken[id] = true
+	b.ids["@id "+id] = id
+	for _, key := range keys {
+		if _, ok := b.ids[key]; !ok {
+			b.ids[key] = id
+		}
+	}
+	return id
+}
+
+// newID returns an unused @id for an entity of the given type, e.g. "https://www.example.com/#person-2".
+func (b *graphBuilder) newID(typ string) string {
+	fragment := strings.ToLower(typ)
+	if fragment == "" {
+		fragment = "thing"
+	}
+	id := b.baseURL + "#" + fragment
+	for n := 2; b.taken[id]; n++ {
+		id = b.baseURL + "#" + fragment + "-" + strconv.Itoa(n)
+	}
+	return id
+}
+
+// count counts the nested entities of v, skipping v itself when root is true.
+func (b *graphBuilder) count(v any, root bool) {
+	switch value := v.(type) {
+	case map[string]any:
+		if !root && isEntityNode(value) {
+			if keys := nodeKeys(value); len(keys) > 0 {
+				b.counts[keys[0]]++
+			}
+		}
+		for _, nested := range value {
+			b.count(nested, false)
+		}
+	case []any:
+		for _, nested := range value {
+			b.count(nested, false)
+		}
+	}
+}
+
+// link replaces the nested entities of node matching an entity of the graph by a reference.
+// Nested entities occurring more than once are moved to the graph first.
+func (b *graphBuilder) link(node map[string]any) {
+	for _, name := range sortedKeys(node) {
+		node[name] = b.linkValue(node[name])
+	}
+}
+
+func (b *graphBuilder) linkValue(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		if isEntityNode(value) {
+			keys := nodeKeys(value)
+			for _, key := range keys {
+				if id, ok := b.ids[key]; ok {
+					return map[string]any{"@id": id}
+				}
+			}
+			if len(keys) > 0 && b.counts[keys[0]] > 1 {
+				b.nodes = append(b.nodes, value)
+				return map[string]any{"@id": b.register(value)}
+			}
+		}
+		for _, name := range sortedKeys(value) {
+			value[name] = b.linkValue(value[name])
+		}
+		return value
+	case []any:
+		for i, nested := range value {
+			value[i] = b.linkValue(nested)
+		}
+		return value
+	}
+	return v
+}
+
+// decodeNode marshals entity and decodes it as a generic JSON object.
+func decodeNode(entity Entity) (map[string]any, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var node map[string]any
+	if err := dec.Decode(&node); err != nil || node == nil {
+		return nil, fmt.Errorf("schemaorg: graph entity %T is not a JSON object", entity)
+	}
+	delete(node, "@context")
+	return node, nil
+}
+
+// isEntityNode reports whether node is an entity which can be referenced by @id,
+// i.e. a node with an explicit @id or a @type supported as a root entity.
+func isEntityNode(node map[string]any) bool {
+	if _, ok := node["@id"].(string); ok {
+		return true
+	}
+	_, ok := entityTypes[nodeType(node)]
+	return ok
+}
+
+// nodeType returns the first @type of node.
+func nodeType(node map[string]any) string {
+	switch typ := node["@type"].(type) {
+	case string:
+		return typ
+	case []any:
+		if len(typ) > 0 {
+			s, _ := typ[0].(string)
+			return s
+		}
+	}
+	return ""
+}
+
+// nodeKeys returns the identity keys of node, the most specific first.
+func nodeKeys(node map[string]any) []string {
+	var keys []string
+	if id, ok := node["@id"].(string); ok && id != "" {
+		keys = append(keys, "@id "+id)
+	}
+	typ := nodeType(node)
+	if url, ok := node["url"].(string); ok && url != "" {
+		// Entities with a url are distinct from the ones of the same name but another url.
+		return append(keys, typ+" url "+url)
+	}
+	if name, ok := node["name"].(string); ok && name != "" {
+		keys = append(keys, typ+" name "+name)
+	}
+	return keys
+}
+
+// sortedKeys returns the property names of node in sorted order, so entities are moved to
+// the graph in a deterministic order.
+func sortedKeys(node map[string]any) []string {
+	keys := make([]string, 0, len(node))
+	for key := range node {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
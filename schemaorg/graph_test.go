@@ -0,0 +1,114 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestGraphReferences tests the @id assignment and the replacement of nested entities by references
+func TestGraphReferences(t *testing.T) {
+	org := &Organization{Name: "Example", URL: "https://www.example.com"}
+	author := &Person{Name: "Jane Doe"}
+	graph := NewGraph("https://www.example.com/",
+		&WebSite{Name: "Example", URL: "https://www.example.com"},
+		org,
+		&Article{Headline: "First", Author: author, Publisher: org},
+		&Article{ID: "https://www.example.com/second#article", Headline: "Second", Author: author, Publisher: org},
+		nil,
+	)
+
+	data, err := json.Marshal(graph)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var doc struct {
+		Context string           `json:"@context"`
+		Graph   []map[string]any `json:"@graph"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if doc.Context != DefaultContext {
+		t.Errorf("expected @context %q, got %q", DefaultContext, doc.Context)
+	}
+
+	ids := []string{
+		"https://www.example.com/#website",
+		"https://www.example.com/#organization",
+		"https://www.example.com/#article",
+		"https://www.example.com/second#article",
+		"https://www.example.com/#person",
+	}
+	if len(doc.Graph) != len(ids) {
+		t.Fatalf("expected %d entities, got %d in %s", len(ids), len(doc.Graph), data)
+	}
+	for i, id := range ids {
+		if doc.Graph[i]["@id"] != id {
+			t.Errorf("expected @id %q for entity %d, got %v", id, i, doc.Graph[i]["@id"])
+		}
+	}
+
+	for _, article := range doc.Graph[2:4] {
+		publisher, _ := article["publisher"].(map[string]any)
+		if len(publisher) != 1 || publisher["@id"] != ids[1] {
+			t.Errorf("expected publisher reference to %s, got %v", ids[1], article["publisher"])
+		}
+		author, _ := article["author"].(map[string]any)
+		if len(author) != 1 || author["@id"] != ids[4] {
+			t.Errorf("expected author reference to %s, got %v", ids[4], article["author"])
+		}
+	}
+
+	if n := strings.Count(string(data), `"name":"Jane Doe"`); n != 1 {
+		t.Errorf("expected the author once, got %d in %s", n, data)
+	}
+
+	html, err := graph.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Fatalf("ToGoHTMLJsonLd failed: %v", err)
+	}
+	if n := strings.Count(string(html), "application/ld+json"); n != 1 {
+		t.Errorf("expected a single script, got %d", n)
+	}
+
+	entities, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if article, ok := entities[2].(*Article); !ok || article.Publisher == nil || article.Publisher.ID != ids[1] {
+		t.Errorf("expected the publisher reference to be decoded, got %#v", entities[2])
+	}
+}
+
+// TestGraphSameName tests that entities sharing a name but not their url are kept distinct
+func TestGraphSameName(t *testing.T) {
+	graph := NewGraph("https://www.example.com/",
+		&Organization{Name: "Acme", URL: "https://acme.example.com"},
+		&Article{Headline: "Example", Publisher: &Organization{Name: "Acme", URL: "https://acme.example.org"}},
+		&Article{Headline: "Other", Publisher: &Organization{Name: "Acme"}},
+	)
+
+	data, err := json.Marshal(graph)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var doc struct {
+		Graph []map[string]any `json:"@graph"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(doc.Graph) != 3 {
+		t.Fatalf("expected 3 entities, got %d in %s", len(doc.Graph), data)
+	}
+
+	for _, article := range doc.Graph[1:] {
+		publisher, _ := article["publisher"].(map[string]any)
+		if _, ok := publisher["@id"]; ok || publisher["name"] != "Acme" {
+			t.Errorf("expected an inline publisher, got %v", article["publisher"])
+		}
+	}
+}
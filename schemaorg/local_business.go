@@ -1,13 +1,14 @@
 package schemaorg
 
 import (
-	"context"
+	"encoding/json"
 	"fmt"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // LocalBusiness represents a Schema.org LocalBusiness object.
@@ -60,7 +61,8 @@ import (
 //		"description": "This is an example local business"
 //	}
 type LocalBusiness struct {
-	Context         string           `json:"@context"`
+	Context         string           `json:"@context,omitempty"`
+	ID              string           `json:"@id,omitempty"`
 	Type            string           `json:"@type"`
 	Name            string           `json:"name,omitempty"`
 	Description     string           `json:"description,omitempty"`
@@ -101,54 +103,69 @@ func NewLocalBusiness(name string, description string, url string, telephone str
 
 // ToJsonLd converts the LocalBusiness struct to a JSON-LD `templ.Component`.
 func (lb *LocalBusiness) ToJsonLd() templ.Component {
-	lb.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "localBusiness", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, lb).WithType("application/ld+json")
+	return newDocument("localBusiness", lb.Context, lb).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the LocalBusiness struct as `template.HTML` value for Go's `html/template`.
 func (lb *LocalBusiness) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := lb.ToJsonLd()
-
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+	return teseo.RenderGoHTML("schemaorg.LocalBusiness", lb.ToJsonLd())
+}
 
-	return html, nil
+// Render writes the LocalBusiness JSON-LD script to w, without going through templ.
+func (lb *LocalBusiness) Render(w io.Writer) error {
+	return newDocument("localBusiness", lb.Context, lb).Render(w)
 }
 
-// ensureDefaults sets default values for LocalBusiness and its nested objects if they are not already set.
-func (lb *LocalBusiness) ensureDefaults() {
-	if lb.Context == "" {
-		lb.Context = "https://schema.org"
-	}
-
-	if lb.Type == "" {
-		lb.Type = "LocalBusiness"
-	}
+// Validate checks the LocalBusiness against the Google rich results guidelines for local businesses.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/local-business
+func (lb *LocalBusiness) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.LocalBusiness")
+	v.Required("name", validate.NotEmpty(lb.Name))
+	v.Required("address", lb.Address != nil)
+	v.Recommended("url", lb.URL != "")
+	v.Recommended("telephone", lb.Telephone != "")
+	v.Recommended("geo", lb.Geo != nil)
+	v.Recommended("openingHours", len(lb.OpeningHours) > 0)
+	v.URL("url", lb.URL)
 
 	if lb.Logo != nil {
-		lb.Logo.ensureDefaults()
+		lb.Logo.validate(v.Child("logo"))
 	}
 
 	if lb.Address != nil {
-		lb.Address.ensureDefaults()
+		lb.Address.validate(v.Child("address"))
 	}
 
 	if lb.Geo != nil {
-		lb.Geo.ensureDefaults()
+		lb.Geo.validate(v.Child("geo"))
 	}
 
 	if lb.AggregateRating != nil {
-		lb.AggregateRating.ensureDefaults()
+		lb.AggregateRating.validate(v.Child("aggregateRating"))
 	}
 
-	for _, review := range lb.Review {
-		review.ensureDefaults()
+	for i, review := range lb.Review {
+		if review != nil {
+			review.validate(v.Index("review", i))
+		}
 	}
+
+	return v.Issues()
+}
+
+// ensureDefaults sets default values for LocalBusiness and its nested objects if they are not already set.
+func (lb *LocalBusiness) ensureDefaults() {
+	if lb.Type == "" {
+		lb.Type = "LocalBusiness"
+	}
+}
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the LocalBusiness.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (lb LocalBusiness) MarshalJSON() ([]byte, error) {
+	type alias LocalBusiness
+	lb.ensureDefaults()
+	return marshalEntity(alias(lb))
 }
 
 // ensureDefaults sets default values for GeoCoordinates if they are not already set.
@@ -157,3 +174,21 @@ func (geo *GeoCoordinates) ensureDefaults() {
 		geo.Type = "GeoCoordinates"
 	}
 }
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the GeoCoordinates.
+func (geo GeoCoordinates) MarshalJSON() ([]byte, error) {
+	type alias GeoCoordinates
+	geo.ensureDefaults()
+	return json.Marshal(alias(geo))
+}
+
+// validate checks the GeoCoordinates are in range.
+func (geo *GeoCoordinates) validate(v *validate.Validator) {
+	if geo.Latitude < -90 || geo.Latitude > 90 {
+		v.Add(teseo.SeverityError, "latitude", "range", fmt.Sprintf("latitude %v is out of range [-90, 90]", geo.Latitude))
+	}
+
+	if geo.Longitude < -180 || geo.Longitude > 180 {
+		v.Add(teseo.SeverityError, "longitude", "range", fmt.Sprintf("longitude %v is out of range [-180, 180]", geo.Longitude))
+	}
+}
@@ -1,13 +1,13 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
+	"encoding/json"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Person represents a Schema.org Person object.
@@ -71,6 +71,13 @@ func (addr *PostalAddress) ensureDefaults() {
 	}
 }
 
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the PostalAddress.
+func (addr PostalAddress) MarshalJSON() ([]byte, error) {
+	type alias PostalAddress
+	addr.ensureDefaults()
+	return json.Marshal(alias(addr))
+}
+
 // NewPerson initializes a Person with default context and type.
 func NewPerson(name string, url string, email string, image *ImageObject, jobTitle string, worksFor *Organization, sameAs []string, gender string, birthDate string, nationality string, telephone string, address *PostalAddress, affiliation *Organization) *Person {
 	person := &Person{
@@ -94,48 +101,65 @@ func NewPerson(name string, url string, email string, image *ImageObject, jobTit
 
 // ToJsonLd converts the Person struct to a JSON-LD `templ.Component`.
 func (p *Person) ToJsonLd() templ.Component {
-	p.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "person", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, p).WithType("application/ld+json")
+	return newDocument("person", p.Context, p).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the Person struct as `template.HTML` value for Go's `html/template`.
 func (p *Person) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := p.ToJsonLd()
+	return teseo.RenderGoHTML("schemaorg.Person", p.ToJsonLd())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the Person JSON-LD script to w, without going through templ.
+func (p *Person) Render(w io.Writer) error {
+	return newDocument("person", p.Context, p).Render(w)
+}
 
-	return html, nil
+// Validate checks the Person properties.
+func (p *Person) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.Person")
+	p.validate(v)
+	return v.Issues()
 }
 
 // ensureDefaults sets default values for Person and its nested objects if they are not already set.
 func (p *Person) ensureDefaults() {
-	if p.Context == "" {
-		p.Context = "https://schema.org"
-	}
-
 	if p.Type == "" {
 		p.Type = "Person"
 	}
+}
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Person.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (p Person) MarshalJSON() ([]byte, error) {
+	type alias Person
+	p.ensureDefaults()
+	return marshalEntity(alias(p))
+}
+
+// validate checks the Person properties, also when nested in other entities.
+func (p *Person) validate(v *validate.Validator) {
+	v.Required("name", validate.NotEmpty(p.Name))
+	v.URL("url", p.URL)
+	v.URLs("sameAs", p.SameAs)
+	v.Date("birthDate", p.BirthDate)
 
 	if p.Image != nil {
-		p.Image.ensureDefaults()
+		p.Image.validate(v.Child("image"))
 	}
 
 	if p.WorksFor != nil {
-		p.WorksFor.ensureDefaults()
-	}
-
-	if p.Address != nil {
-		p.Address.ensureDefaults()
+		p.WorksFor.validate(v.Child("worksFor"))
 	}
 
 	if p.Affiliation != nil {
-		p.Affiliation.ensureDefaults()
+		p.Affiliation.validate(v.Child("affiliation"))
 	}
 }
+
+// validate checks the PostalAddress properties.
+func (addr *PostalAddress) validate(v *validate.Validator) {
+	v.Recommended("streetAddress", validate.NotEmpty(addr.StreetAddress))
+	v.Recommended("addressLocality", validate.NotEmpty(addr.AddressLocality))
+	v.Recommended("postalCode", validate.NotEmpty(addr.PostalCode))
+	v.Recommended("addressCountry", validate.NotEmpty(addr.AddressCountry))
+}
@@ -1,13 +1,13 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
+	"encoding/json"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Product represents a Schema.org Product object.
@@ -61,7 +61,8 @@ import (
 //		}
 //	}
 type Product struct {
-	Context         string           `json:"@context"`
+	Context         string           `json:"@context,omitempty"`
+	ID              string           `json:"@id,omitempty"`
 	Type            string           `json:"@type"`
 	Name            string           `json:"name,omitempty"`
 	Description     string           `json:"description,omitempty"`
@@ -132,53 +133,69 @@ func NewProduct(name, description string, image []string, sku string, brand *Bra
 
 // ToJsonLd converts the Product struct to a JSON-LD `templ.Component`.
 func (p *Product) ToJsonLd() templ.Component {
-	p.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "product", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, p).WithType("application/ld+json")
+	return newDocument("product", p.Context, p).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the Product struct as `template.HTML` value for Go's `html/template`.
 func (p *Product) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := p.ToJsonLd()
-
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
-
-	return html, nil
+	return teseo.RenderGoHTML("schemaorg.Product", p.ToJsonLd())
 }
 
-// ensureDefaults sets default values for Product and its nested objects if they are not already set.
-func (p *Product) ensureDefaults() {
-	if p.Context == "" {
-		p.Context = "https://schema.org"
-	}
+// Render writes the Product JSON-LD script to w, without going through templ.
+func (p *Product) Render(w io.Writer) error {
+	return newDocument("product", p.Context, p).Render(w)
+}
 
-	if p.Type == "" {
-		p.Type = "Product"
+// Validate checks the Product against the Google rich results guidelines for product snippets.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/product-snippet
+func (p *Product) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.Product")
+	v.Required("name", validate.NotEmpty(p.Name))
+	if p.Offers == nil && len(p.Review) == 0 && p.AggregateRating == nil {
+		v.Add(teseo.SeverityError, "offers", "oneOf", `one of "offers", "review" or "aggregateRating" is required`)
 	}
+	v.Recommended("image", len(p.Image) > 0)
+	v.Recommended("description", validate.NotEmpty(p.Description))
+	v.Recommended("sku", p.SKU != "")
+	v.Recommended("brand", p.Brand != nil)
+	v.URLs("image", p.Image)
 
 	if p.Brand != nil {
-		p.Brand.ensureDefaults()
+		v.Child("brand").Required("name", validate.NotEmpty(p.Brand.Name))
 	}
 
 	if p.Offers != nil {
-		p.Offers.ensureDefaults()
+		p.Offers.validate(v.Child("offers"))
 	}
 
 	if p.AggregateRating != nil {
-		p.AggregateRating.ensureDefaults()
+		p.AggregateRating.validate(v.Child("aggregateRating"))
 	}
 
-	for _, review := range p.Review {
-		review.ensureDefaults()
+	for i, review := range p.Review {
+		if review != nil {
+			review.validate(v.Index("review", i))
+		}
+	}
 
+	return v.Issues()
+}
+
+// ensureDefaults sets default values for Product and its nested objects if they are not already set.
+func (p *Product) ensureDefaults() {
+	if p.Type == "" {
+		p.Type = "Product"
 	}
 }
 
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Product.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (p Product) MarshalJSON() ([]byte, error) {
+	type alias Product
+	p.ensureDefaults()
+	return marshalEntity(alias(p))
+}
+
 // ensureDefaults sets default values for Brand if they are not already set.
 func (b *Brand) ensureDefaults() {
 	if b.Type == "" {
@@ -186,6 +203,13 @@ func (b *Brand) ensureDefaults() {
 	}
 }
 
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Brand.
+func (b Brand) MarshalJSON() ([]byte, error) {
+	type alias Brand
+	b.ensureDefaults()
+	return json.Marshal(alias(b))
+}
+
 // ensureDefaults sets default values for Offer if they are not already set.
 func (o *Offer) ensureDefaults() {
 	if o.Type == "" {
@@ -193,6 +217,13 @@ func (o *Offer) ensureDefaults() {
 	}
 }
 
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Offer.
+func (o Offer) MarshalJSON() ([]byte, error) {
+	type alias Offer
+	o.ensureDefaults()
+	return json.Marshal(alias(o))
+}
+
 // ensureDefaults sets default values for AggregateRating if they are not already set.
 func (ar *AggregateRating) ensureDefaults() {
 	if ar.Type == "" {
@@ -200,19 +231,25 @@ func (ar *AggregateRating) ensureDefaults() {
 	}
 }
 
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the AggregateRating.
+func (ar AggregateRating) MarshalJSON() ([]byte, error) {
+	type alias AggregateRating
+	ar.ensureDefaults()
+	return json.Marshal(alias(ar))
+}
+
 // ensureDefaults sets default values for Review if they are not already set.
 func (r *Review) ensureDefaults() {
 	if r.Type == "" {
 		r.Type = "Review"
 	}
+}
 
-	if r.Author != nil {
-		r.Author.ensureDefaults()
-	}
-
-	if r.ReviewRating != nil {
-		r.ReviewRating.ensureDefaults()
-	}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Review.
+func (r Review) MarshalJSON() ([]byte, error) {
+	type alias Review
+	r.ensureDefaults()
+	return json.Marshal(alias(r))
 }
 
 // ensureDefaults sets default values for Rating if they are not already set.
@@ -221,3 +258,40 @@ func (ra *Rating) ensureDefaults() {
 		ra.Type = "Rating"
 	}
 }
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Rating.
+func (ra Rating) MarshalJSON() ([]byte, error) {
+	type alias Rating
+	ra.ensureDefaults()
+	return json.Marshal(alias(ra))
+}
+
+// validate checks the Offer properties.
+func (o *Offer) validate(v *validate.Validator) {
+	v.Required("price", o.Price != "")
+	v.Required("priceCurrency", o.PriceCurrency != "")
+	v.Recommended("availability", o.Availability != "")
+	v.URL("url", o.URL)
+}
+
+// validate checks the AggregateRating properties.
+func (ar *AggregateRating) validate(v *validate.Validator) {
+	v.Required("ratingValue", ar.RatingValue > 0)
+	v.Required("reviewCount", ar.ReviewCount > 0)
+}
+
+// validate checks the Review properties.
+func (r *Review) validate(v *validate.Validator) {
+	v.Required("author", r.Author != nil)
+	v.Required("reviewRating", r.ReviewRating != nil)
+	v.Recommended("datePublished", r.DatePublished != "")
+	v.Date("datePublished", r.DatePublished)
+
+	if r.Author != nil {
+		r.Author.validate(v.Child("author"))
+	}
+
+	if r.ReviewRating != nil {
+		v.Child("reviewRating").Required("ratingValue", r.ReviewRating.RatingValue > 0)
+	}
+}
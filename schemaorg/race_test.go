@@ -0,0 +1,102 @@
+package schemaorg
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// sharedEntities returns one entity of each type with nested objects left without defaults
+func sharedEntities() map[string]interface {
+	ToJsonLd() templ.Component
+} {
+	org := &Organization{Name: "Example Inc.", Logo: &ImageObject{URL: "https://www.example.com/logo.png"}}
+	person := &Person{Name: "Jane Doe", WorksFor: org, Address: &PostalAddress{AddressLocality: "Rome"}}
+	review := &Review{Author: person, ReviewRating: &Rating{RatingValue: 5}}
+
+	return map[string]interface {
+		ToJsonLd() templ.Component
+	}{
+		"Article":        &Article{Headline: "Example", Author: person, Publisher: org},
+		"BreadcrumbList": &BreadcrumbList{ItemListElement: []ListItem{{Name: "Home", Item: "https://www.example.com", Position: 1}}},
+		"Event":          &Event{Name: "Example", Location: &Place{Name: "Venue", Geo: &GeoCoordinates{Latitude: 1}}, Organizer: org, Performer: person, Offers: &Offer{Price: "10"}},
+		"FAQPage":        &FAQPage{MainEntity: []*Question{{Name: "Why?", AcceptedAnswer: &Answer{Text: "Because."}}}},
+		"LocalBusiness":  &LocalBusiness{Name: "Example", Logo: &ImageObject{URL: "https://www.example.com/logo.png"}, Geo: &GeoCoordinates{Latitude: 1}, AggregateRating: &AggregateRating{RatingValue: 4.5}, Review: []*Review{review}},
+		"Organization":   org,
+		"Person":         person,
+		"Product":        &Product{Name: "Example", Brand: &Brand{Name: "Brand"}, Offers: &Offer{Price: "10"}, AggregateRating: &AggregateRating{RatingValue: 4.5}, Review: []*Review{review}},
+		"SiteNavigationElement": &SiteNavigationElement{
+			Name:     "Main Navigation",
+			ItemList: &ItemList{ItemListElement: []ItemListElement{{Name: "Home", URL: "https://www.example.com", Position: 1}}},
+		},
+		"WebPage": &WebPage{Name: "Example"},
+		"WebSite": &WebSite{Name: "Example", PotentialAction: &Action{Target: &Target{URLTemplate: "https://www.example.com/search?q={q}"}}},
+	}
+}
+
+// TestConcurrentToJsonLd renders shared entities from many goroutines, run it with -race.
+// Rendering must not modify the entities.
+func TestConcurrentToJsonLd(t *testing.T) {
+	for name, entity := range sharedEntities() {
+		t.Run(name, func(t *testing.T) {
+			before := snapshot(t, entity)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := templ.ToGoHTML(context.Background(), entity.ToJsonLd()); err != nil {
+						t.Errorf("ToJsonLd failed: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if after := snapshot(t, entity); after != before {
+				t.Errorf("rendering modified the %s.\nBefore:\n%s\nAfter:\n%s", name, before, after)
+			}
+		})
+	}
+}
+
+// snapshot returns the JSON encoding of the fields of v, following pointers and without the MarshalJSON
+// methods, which would hide the defaults set on the nested objects.
+func snapshot(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(plain(reflect.ValueOf(v)))
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	return string(data)
+}
+
+// plain converts v to maps, slices and basic values.
+func plain(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return plain(v.Elem())
+	case reflect.Struct:
+		fields := map[string]any{}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fields[v.Type().Field(i).Name] = plain(v.Field(i))
+			}
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = plain(v.Index(i))
+		}
+		return items
+	}
+	return v.Interface()
+}
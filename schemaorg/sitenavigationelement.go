@@ -1,16 +1,17 @@
 package schemaorg
 
 import (
-	"context"
 	"encoding/xml"
 	"fmt"
 	"html/template"
 	"io"
-	"log"
 	"os"
+	"time"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+	"github.com/indaco/teseo/sitemap"
 )
 
 // SiteNavigationElement represents a Schema.org SiteNavigationElement object.
@@ -73,8 +74,8 @@ import (
 //		URL:  "https://www.example.com",
 //		ItemList: &schemaorg.ItemList{
 //			ItemListElement: []schemaorg.ItemListElement{
-//				{Name: "Home", URL: "https://www.example.com", Position: 1},
-//				{Name: "About", URL: "https://www.example.com/about", Position: 2},
+//				{Name: "Home", URL: "https://www.example.com", Position: 1, ChangeFreq: sitemap.Daily, Priority: sitemap.NewPriority(1)},
+//				{Name: "About", URL: "https://www.example.com/about", Position: 2, Priority: sitemap.NewPriority(0.5)},
 //			},
 //		},
 //	}
@@ -98,7 +99,8 @@ import (
 //	<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
 //	  <url>
 //	    <loc>http://www.example.com/</loc>
-//	    <priority>0.5</priority>
+//	    <changefreq>daily</changefreq>
+//	    <priority>1.0</priority>
 //	  </url>
 //	  <url>
 //	    <loc>http://www.example.com/about</loc>
@@ -106,7 +108,8 @@ import (
 //	  </url>
 //	</urlset>
 type SiteNavigationElement struct {
-	Context    string    `json:"@context"`
+	Context    string    `json:"@context,omitempty"`
+	ID         string    `json:"@id,omitempty"`
 	Type       string    `json:"@type"`
 	Name       string    `json:"name,omitempty"`
 	URL        string    `json:"url,omitempty"`
@@ -117,26 +120,34 @@ type SiteNavigationElement struct {
 
 // ItemList represents a Schema.org ItemList object
 type ItemList struct {
-	Context         string            `json:"@context"`
+	Context         string            `json:"@context,omitempty"`
 	Type            string            `json:"@type"`
 	ItemListElement []ItemListElement `json:"itemListElement"`
 }
 
-// ItemListElement represents an individual item in an ItemList
+// ItemListElement represents an individual item in an ItemList.
+// LastMod, ChangeFreq and Priority are not part of the JSON-LD output, they are written to the sitemap only.
 type ItemListElement struct {
-	Type     string `json:"@type"`
-	Name     string `json:"name,omitempty"`
-	URL      string `json:"url,omitempty"`
-	Position int    `json:"position,omitempty"`
+	Type       string             `json:"@type"`
+	Name       string             `json:"name,omitempty"`
+	URL        string             `json:"url,omitempty"`
+	Position   int                `json:"position,omitempty"`
+	LastMod    time.Time          `json:"-"`
+	ChangeFreq sitemap.ChangeFreq `json:"-"`
+	Priority   *sitemap.Priority  `json:"-"`
 }
 
 // XMLSitemapUrl represents a single URL entry in the sitemap XML.
+//
+// Deprecated: use sitemap.URL instead.
 type XMLSitemapUrl struct {
 	Loc      string `xml:"loc"`
 	Priority string `xml:"priority,omitempty"`
 }
 
 // XMLSitemap represents the structure of a sitemap XML file.
+//
+// Deprecated: use sitemap.Sitemap instead.
 type XMLSitemap struct {
 	XMLName xml.Name        `xml:"urlset"`
 	Xmlns   string          `xml:"xmlns,attr"`
@@ -199,111 +210,118 @@ func NewItemList(elements []ItemListElement) ItemList {
 
 // ToJsonLd converts the SiteNavigationElement struct to a JSON-LD `templ.Component`.
 func (sne *SiteNavigationElement) ToJsonLd() templ.Component {
-	sne.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "siteNavElem", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, sne).WithType("application/ld+json")
+	return newDocument("siteNavElem", sne.Context, sne).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the SiteNavigationElement struct as `template.HTML` value for Go's `html/template`.
 func (sne *SiteNavigationElement) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := sne.ToJsonLd()
+	return teseo.RenderGoHTML("schemaorg.SiteNavigationElement", sne.ToJsonLd())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
+// Render writes the SiteNavigationElement JSON-LD script to w, without going through templ.
+func (sne *SiteNavigationElement) Render(w io.Writer) error {
+	return newDocument("siteNavElem", sne.Context, sne).Render(w)
+}
+
+// Validate checks the SiteNavigationElement properties.
+func (sne *SiteNavigationElement) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.SiteNavigationElement")
+	v.Recommended("name", validate.NotEmpty(sne.Name))
+	v.Recommended("url", sne.URL != "")
+	v.URL("url", sne.URL)
+
+	if sne.ItemList != nil {
+		lv := v.Child("itemList")
+		for i, item := range sne.ItemList.ItemListElement {
+			iv := lv.Index("itemListElement", i)
+			iv.Recommended("name", validate.NotEmpty(item.Name))
+			iv.Required("url", item.URL != "")
+			iv.URL("url", item.URL)
+		}
 	}
 
-	return html, nil
+	return v.Issues()
 }
 
-// ToSitemapFile generates a sitemap XML file from the SiteNavigationElement struct.
-func (s *SiteNavigationElement) ToSitemapFile(filename string) error {
+// ToSitemap returns a sitemap.Sitemap listing the URLs of the ItemList.
+func (s *SiteNavigationElement) ToSitemap() (*sitemap.Sitemap, error) {
 	if s.ItemList == nil {
-		return fmt.Errorf("ItemList is nil, cannot generate sitemap")
+		return nil, fmt.Errorf("ItemList is nil, cannot generate sitemap")
 	}
 
-	// Populate the XML structure with the necessary namespace
-	var sitemap XMLSitemap
-	sitemap.Xmlns = "http://www.sitemaps.org/schemas/sitemap/0.9" // Set the namespace
-
+	sm := sitemap.New()
 	for _, item := range s.ItemList.ItemListElement {
-		// Add each item as an XML sitemap URL entry
-		url := XMLSitemapUrl{
-			Loc:      item.URL,
-			Priority: "0.5", // Example priority, can be adjusted or made dynamic
-		}
-		sitemap.Urls = append(sitemap.Urls, url)
+		sm.Add(sitemap.URL{
+			Loc:        item.URL,
+			LastMod:    item.LastMod,
+			ChangeFreq: item.ChangeFreq,
+			Priority:   item.Priority,
+		})
 	}
+	return sm, nil
+}
 
-	// Marshal the sitemap struct to XML
-	xmlData, err := xml.MarshalIndent(sitemap, "", "  ")
-	if err != nil {
-		return fmt.Errorf("error marshaling sitemap to XML: %v", err)
+// FromSitemap populates the SiteNavigationElement struct with the URLs of sm.
+func (s *SiteNavigationElement) FromSitemap(sm *sitemap.Sitemap) {
+	s.Context = "https://schema.org"
+	s.Type = "SiteNavigationElement"
+	s.ItemList = &ItemList{
+		Context: "https://schema.org",
+		Type:    "ItemList",
 	}
 
-	// Add the XML header
-	xmlData = append([]byte(xml.Header), xmlData...)
-
-	// Write the XML data to a file
-	err = os.WriteFile(filename, xmlData, 0644)
-	if err != nil {
-		return fmt.Errorf("error writing XML file: %v", err)
+	for i, url := range sm.URLs {
+		// Add each URL as an ItemListElement in the ItemList
+		item := ItemListElement{
+			Type:       "SiteNavigationElement",
+			URL:        url.Loc,
+			Position:   i + 1, // Assign position incrementally
+			LastMod:    url.LastMod,
+			ChangeFreq: url.ChangeFreq,
+			Priority:   url.Priority,
+		}
+		s.ItemList.ItemListElement = append(s.ItemList.ItemListElement, item)
 	}
-
-	return nil
 }
 
-// FromSitemapFile parses a sitemap XML file and populates the SiteNavigationElement struct.
-func (s *SiteNavigationElement) FromSitemapFile(filename string) error {
-	// Open the XML file
-	xmlFile, err := os.Open(filename)
+// ToSitemapFile generates a sitemap XML file from the SiteNavigationElement struct.
+// See ToSitemap to write the sitemap to any `io.Writer`.
+func (s *SiteNavigationElement) ToSitemapFile(filename string) error {
+	sm, err := s.ToSitemap()
 	if err != nil {
-		return fmt.Errorf("could not open XML file: %v", err)
+		return err
 	}
-	defer xmlFile.Close()
 
-	// Read the file content
-	byteValue, err := io.ReadAll(xmlFile)
+	f, err := os.Create(filename)
 	if err != nil {
-		return fmt.Errorf("could not read XML file: %v", err)
+		return fmt.Errorf("error creating XML file: %w", err)
 	}
+	if err := sm.Write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing XML file: %w", err)
+	}
+	return f.Close()
+}
 
-	// Parse the XML content
-	var sitemap XMLSitemap
-	err = xml.Unmarshal(byteValue, &sitemap)
+// FromSitemapFile parses a sitemap XML file and populates the SiteNavigationElement struct.
+// See sitemap.Load to read the sitemap from any `fs.FS`.
+func (s *SiteNavigationElement) FromSitemapFile(filename string) error {
+	f, err := os.Open(filename)
 	if err != nil {
-		return fmt.Errorf("could not unmarshal XML content: %v", err)
+		return fmt.Errorf("could not open XML file: %w", err)
 	}
+	defer f.Close()
 
-	// Populate the SiteNavigationElement struct from the parsed XML
-	s.Context = "https://schema.org"
-	s.Type = "SiteNavigationElement"
-	s.ItemList = &ItemList{
-		Context: "https://schema.org",
-		Type:    "ItemList",
+	sm := &sitemap.Sitemap{}
+	if err := sm.Read(f); err != nil {
+		return fmt.Errorf("could not read XML file: %w", err)
 	}
-
-	for i, url := range sitemap.Urls {
-		// Add each URL as an ItemListElement in the ItemList
-		item := ItemListElement{
-			Type:     "SiteNavigationElement",
-			URL:      url.Loc,
-			Position: i + 1, // Assign position incrementally
-		}
-		s.ItemList.ItemListElement = append(s.ItemList.ItemListElement, item)
-	}
-
+	s.FromSitemap(sm)
 	return nil
 }
 
-// makeSiteNavigationElement initializes a SiteNavigationElement with default context and type.
+// ensureDefaults sets default values for SiteNavigationElement if they are not already set.
 func (sne *SiteNavigationElement) ensureDefaults() {
-	if sne.Context == "" {
-		sne.Context = "https://schema.org"
-	}
-
 	if sne.Type == "" {
 		sne.Type = "SiteNavigationElement"
 	}
@@ -311,12 +329,27 @@ func (sne *SiteNavigationElement) ensureDefaults() {
 	if sne.Position == 0 {
 		sne.Position = 1
 	}
+}
 
-	if sne.ItemList != nil && len(sne.ItemList.ItemListElement) > 0 {
-		sne.ItemList = &ItemList{
-			Context:         "https://schema.org",
-			Type:            "ItemList",
-			ItemListElement: sne.ItemList.ItemListElement,
-		}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the SiteNavigationElement.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (sne SiteNavigationElement) MarshalJSON() ([]byte, error) {
+	type alias SiteNavigationElement
+	sne.ensureDefaults()
+	return marshalEntity(alias(sne))
+}
+
+// ensureDefaults sets default values for ItemList if they are not already set.
+func (il *ItemList) ensureDefaults() {
+	if il.Type == "" {
+		il.Type = "ItemList"
 	}
 }
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the ItemList.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (il ItemList) MarshalJSON() ([]byte, error) {
+	type alias ItemList
+	il.ensureDefaults()
+	return marshalEntity(alias(il))
+}
@@ -5,6 +5,9 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/indaco/teseo/sitemap"
 )
 
 // Sample XML data for testing
@@ -32,11 +35,13 @@ var sampleSiteNav = &SiteNavigationElement{
 				Type:     "SiteNavigationElement",
 				URL:      "http://www.example.com/",
 				Position: 1,
+				Priority: sitemap.NewPriority(0.5),
 			},
 			{
 				Type:     "SiteNavigationElement",
 				URL:      "http://www.example.com/about",
 				Position: 2,
+				Priority: sitemap.NewPriority(0.5),
 			},
 		},
 	},
@@ -103,3 +108,49 @@ func TestFromSitemapFile(t *testing.T) {
 		t.Errorf("Loaded SiteNavigationElement does not match expected struct.\nExpected:\n%+v\nGot:\n%+v", sampleSiteNav, &siteNav)
 	}
 }
+
+// TestSitemapRoundTrip tests that lastmod, changefreq and priority are preserved and kept out of the JSON-LD output
+func TestSitemapRoundTrip(t *testing.T) {
+	lastMod := time.Date(2024, 9, 1, 10, 30, 0, 0, time.UTC)
+	sne := NewSiteNavigationElementWithItemList("Main Navigation", "https://www.example.com", []ItemListElement{
+		{Name: "Home", URL: "https://www.example.com/", Position: 1, LastMod: lastMod, ChangeFreq: sitemap.Daily, Priority: sitemap.NewPriority(1)},
+		{Name: "About", URL: "https://www.example.com/about", Position: 2},
+		{Name: "Archive", URL: "https://www.example.com/archive", Position: 3, Priority: sitemap.NewPriority(0)},
+	})
+
+	sm, err := sne.ToSitemap()
+	if err != nil {
+		t.Fatalf("ToSitemap failed: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := sm.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	read := &sitemap.Sitemap{}
+	if err := read.Read(&buf); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+
+	var loaded SiteNavigationElement
+	loaded.FromSitemap(read)
+	home, about, archive := loaded.ItemList.ItemListElement[0], loaded.ItemList.ItemListElement[1], loaded.ItemList.ItemListElement[2]
+	if !home.LastMod.Equal(lastMod) || home.ChangeFreq != sitemap.Daily || home.Priority == nil || *home.Priority != 1 {
+		t.Errorf("unexpected home entry %+v", home)
+	}
+	if !about.LastMod.IsZero() || about.ChangeFreq != "" || about.Priority != nil {
+		t.Errorf("unexpected about entry %+v", about)
+	}
+	if archive.Priority == nil || *archive.Priority != 0 {
+		t.Errorf("expected an explicit 0.0 priority, got %+v", archive)
+	}
+
+	html, err := sne.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Fatalf("ToGoHTMLJsonLd failed: %v", err)
+	}
+	for _, key := range []string{"LastMod", "ChangeFreq", "Priority", "daily"} {
+		if bytes.Contains([]byte(html), []byte(key)) {
+			t.Errorf("unexpected %s in JSON-LD output: %s", key, html)
+		}
+	}
+}
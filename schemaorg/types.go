@@ -1,13 +1,13 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
+	"encoding/json"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // Common type definitions used across multiple JSON-LD entities
@@ -36,10 +36,24 @@ func (img *ImageObject) ensureDefaults() {
 	}
 }
 
+// validate checks the ImageObject properties.
+func (img *ImageObject) validate(v *validate.Validator) {
+	v.Required("url", img.URL != "")
+	v.URL("url", img.URL)
+}
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the ImageObject.
+func (img ImageObject) MarshalJSON() ([]byte, error) {
+	type alias ImageObject
+	img.ensureDefaults()
+	return json.Marshal(alias(img))
+}
+
 // Organization represents a Schema.org Organization object
 // For more details about the meaning of the properties see: https://schema.org/Organization
 type Organization struct {
-	Context       string         `json:"@context"`
+	Context       string         `json:"@context,omitempty"`
+	ID            string         `json:"@id,omitempty"`
 	Type          string         `json:"@type"`
 	Name          string         `json:"name,omitempty"`
 	URL           string         `json:"url,omitempty"`
@@ -49,44 +63,79 @@ type Organization struct {
 }
 
 func (org *Organization) ensureDefaults() {
-	if org.Context == "" {
-		org.Context = "https://schema.org"
-	}
-
 	if org.Type == "" {
 		org.Type = "Organization"
 	}
+}
 
-	if org.Logo != nil {
-		org.Logo.ensureDefaults()
-	}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Organization.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (org Organization) MarshalJSON() ([]byte, error) {
+	type alias Organization
+	org.ensureDefaults()
+	return marshalEntity(alias(org))
 }
 
 // ToJsonLd converts the Organization struct to a JSON-LD `templ.Component`.
 func (org *Organization) ToJsonLd() templ.Component {
-	org.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "org", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, org).WithType("application/ld+json")
+	return newDocument("org", org.Context, org).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the Organization struct as `template.HTML` value for Go's `html/template`.
 func (org *Organization) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := org.ToJsonLd()
+	return teseo.RenderGoHTML("schemaorg.Organization", org.ToJsonLd())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
+// Render writes the Organization JSON-LD script to w, without going through templ.
+func (org *Organization) Render(w io.Writer) error {
+	return newDocument("org", org.Context, org).Render(w)
+}
+
+// newPublisher returns the Organization of the site publisher, nil when publisher is nil.
+func newPublisher(publisher *teseo.Publisher) *Organization {
+	if publisher == nil {
+		return nil
+	}
+	org := &Organization{Name: publisher.Name, URL: publisher.URL, SameAs: publisher.SameAs}
+	if publisher.Logo != "" {
+		org.Logo = &ImageObject{URL: publisher.Logo}
 	}
+	return org
+}
+
+// fill sets *field to value when it is empty.
+func fill(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
+// Validate checks the Organization against the Google rich results guidelines for organizations.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/organization
+func (org *Organization) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.Organization")
+	org.validate(v)
+	return v.Issues()
+}
+
+// validate checks the Organization properties, also when nested in other entities.
+func (org *Organization) validate(v *validate.Validator) {
+	v.Recommended("name", validate.NotEmpty(org.Name))
+	v.Recommended("url", org.URL != "")
+	v.Recommended("logo", org.Logo != nil)
+	v.URL("url", org.URL)
+	v.URLs("sameAs", org.SameAs)
 
-	return html, nil
+	if org.Logo != nil {
+		org.Logo.validate(v.Child("logo"))
+	}
 }
 
 // Person represents a Schema.org Person object
 // For more details about the meaning of the properties see: https://schema.org/Person
 type Person struct {
-	Context     string         `json:"@context"`
+	Context     string         `json:"@context,omitempty"`
+	ID          string         `json:"@id,omitempty"`
 	Type        string         `json:"@type"`
 	Name        string         `json:"name,omitempty"`
 	URL         string         `json:"url,omitempty"`
@@ -111,3 +160,17 @@ type ListItem struct {
 	Name     string `json:"name,omitempty"`
 	Item     string `json:"item,omitempty"`
 }
+
+// ensureDefaults sets default values for ListItem if they are not already set.
+func (li *ListItem) ensureDefaults() {
+	if li.Type == "" {
+		li.Type = "ListItem"
+	}
+}
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the ListItem.
+func (li ListItem) MarshalJSON() ([]byte, error) {
+	type alias ListItem
+	li.ensureDefaults()
+	return json.Marshal(alias(li))
+}
@@ -0,0 +1,351 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// Entity is the interface implemented by every Schema.org entity rendered as JSON-LD.
+type Entity interface {
+	ToJsonLd() templ.Component
+	ToGoHTMLJsonLd() (template.HTML, error)
+	Render(w io.Writer) error
+	Validate() []teseo.Issue
+}
+
+// entityTypes maps the supported @type values, including common subtypes, to a constructor of the matching struct.
+var entityTypes = map[string]func() Entity{
+	"Article":               func() Entity { return &Article{} },
+	"NewsArticle":           func() Entity { return &Article{} },
+	"BlogPosting":           func() Entity { return &Article{} },
+	"BreadcrumbList":        func() Entity { return &BreadcrumbList{} },
+	"Event":                 func() Entity { return &Event{} },
+	"FAQPage":               func() Entity { return &FAQPage{} },
+	"LocalBusiness":         func() Entity { return &LocalBusiness{} },
+	"Restaurant":            func() Entity { return &LocalBusiness{} },
+	"Store":                 func() Entity { return &LocalBusiness{} },
+	"Organization":          func() Entity { return &Organization{} },
+	"Corporation":           func() Entity { return &Organization{} },
+	"Person":                func() Entity { return &Person{} },
+	"Product":               func() Entity { return &Product{} },
+	"SiteNavigationElement": func() Entity { return &SiteNavigationElement{} },
+	"WebPage":               func() Entity { return &WebPage{} },
+	"WebSite":               func() Entity { return &WebSite{} },
+}
+
+// Generic is an entity whose @type is not supported by teseo. It keeps the original JSON,
+// which is rendered unchanged by ToJsonLd, with DefaultContext as @context when it has none.
+type Generic struct {
+	Types []string        // Values of @type
+	Raw   json.RawMessage // Original JSON
+}
+
+// ToJsonLd renders the original JSON as a JSON-LD `templ.Component`.
+func (g *Generic) ToJsonLd() templ.Component {
+	return teseo.JsonLdScript("thing", g.document())
+}
+
+// ToGoHTMLJsonLd renders the original JSON as `template.HTML` value for Go's `html/template`.
+func (g *Generic) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderGoHTML("schemaorg.Generic", g.ToJsonLd())
+}
+
+// Render writes the original JSON as a JSON-LD script to w, without going through templ.
+func (g *Generic) Render(w io.Writer) error {
+	return teseo.WriteJsonLd(w, "thing", g.document())
+}
+
+// document returns the original JSON with DefaultContext as @context when it has none,
+// e.g. an entity of a `@graph` without @context.
+func (g *Generic) document() json.RawMessage {
+	return withContext(g.Raw, json.RawMessage(strconv.Quote(DefaultContext)))
+}
+
+// MarshalJSON implements json.Marshaler, returning the original JSON with DefaultContext
+// as @context when it has none.
+func (g Generic) MarshalJSON() ([]byte, error) {
+	return g.document(), nil
+}
+
+// Validate returns no issues, the rules of unsupported types are unknown.
+func (g *Generic) Validate() []teseo.Issue {
+	return nil
+}
+
+// Unmarshal decodes a JSON-LD document into the schemaorg structs matching the @type of each entity,
+// e.g. *Product, *Event or *LocalBusiness. The document can hold a single entity, an array of entities
+// or a `@graph`. When @type is an array the first supported type is used.
+//
+// The common variations of real-world pages are accepted: a single value where the struct expects
+// an array (e.g. `"image": "https://..."`), an array where it expects a single object (e.g. several
+// offers or authors, the first one is kept), a number where it expects a string (e.g. `"price": 29.99`)
+// and a string where it expects a number (e.g. `"ratingValue": "4.5"`). Entities whose type is not
+// supported, or whose properties still do not fit the struct, are returned as *Generic.
+//
+// Example usage:
+//
+//	entities, err := schemaorg.Unmarshal([]byte(`{"@context": "https://schema.org", "@type": "Product", "name": "Example"}`))
+//	if err != nil {
+//		return err
+//	}
+//
+//	if product, ok := entities[0].(*schemaorg.Product); ok {
+//		fmt.Println(product.Name)
+//	}
+func Unmarshal(data []byte) ([]Entity, error) {
+	raws, err := SplitJsonLd(data)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]Entity, 0, len(raws))
+	for i, raw := range raws {
+		entity, err := UnmarshalEntity(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode JSON-LD entity %d: %w", i, err)
+		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
+// SplitJsonLd returns the raw entities of a JSON-LD document holding an entity, an array of entities
+// or a `@graph`, in document order. A `@graph` nested in an array is expanded too.
+// The entities of a `@graph` without their own @context get the @context of the document.
+func SplitJsonLd(data []byte) ([]json.RawMessage, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON-LD: %w", err)
+		}
+
+		var raws []json.RawMessage
+		for _, item := range items {
+			nested, err := SplitJsonLd(item)
+			if err != nil {
+				return nil, err
+			}
+			raws = append(raws, nested...)
+		}
+		return raws, nil
+	}
+
+	var doc struct {
+		Context json.RawMessage   `json:"@context"`
+		Graph   []json.RawMessage `json:"@graph"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON-LD: %w", err)
+	}
+	if doc.Graph != nil {
+		if doc.Context != nil {
+			for i, raw := range doc.Graph {
+				doc.Graph[i] = withContext(raw, doc.Context)
+			}
+		}
+		return doc.Graph, nil
+	}
+	return []json.RawMessage{data}, nil
+}
+
+// withContext returns the JSON object raw with context as @context when it has none.
+// Other values are returned unchanged.
+func withContext(raw, context json.RawMessage) json.RawMessage {
+	raw = bytes.TrimSpace(raw)
+	var fields map[string]json.RawMessage
+	if len(raw) == 0 || raw[0] != '{' || json.Unmarshal(raw, &fields) != nil {
+		return raw
+	}
+	if _, ok := fields["@context"]; ok {
+		return raw
+	}
+
+	data := make([]byte, 0, len(raw)+len(context)+13)
+	data = append(data, `{"@context":`...)
+	data = append(data, context...)
+	if len(fields) > 0 {
+		data = append(data, ',')
+	}
+	return append(data, bytes.TrimSpace(raw[1:])...)
+}
+
+// UnmarshalEntity decodes a single JSON-LD entity, e.g. one returned by SplitJsonLd, into the struct
+// matching its @type, as documented on Unmarshal. It fails only when raw is not a JSON object
+// or its @type is neither a string nor an array of strings.
+func UnmarshalEntity(raw json.RawMessage) (Entity, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	types, err := decodeTypes(fields["@type"])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range types {
+		newEntity, ok := entityTypes[t]
+		if !ok {
+			continue
+		}
+
+		normalized := false
+		// Normalize @type arrays to the supported type so they fit the Type string fields.
+		if len(types) > 1 {
+			fields["@type"], _ = json.Marshal(t)
+			normalized = true
+		}
+		// Drop structured contexts (arrays, objects) which do not fit the Context string fields.
+		if context, ok := fields["@context"]; ok && !bytes.HasPrefix(bytes.TrimSpace(context), []byte(`"`)) {
+			delete(fields, "@context")
+			normalized = true
+		}
+		data := raw
+		if normalized {
+			if data, err = json.Marshal(fields); err != nil {
+				return nil, err
+			}
+		}
+
+		entity := newEntity()
+		if err := json.Unmarshal(normalize(reflect.TypeOf(entity), data), entity); err != nil {
+			break
+		}
+		return entity, nil
+	}
+
+	return &Generic{Types: types, Raw: raw}, nil
+}
+
+// normalize rewrites the JSON value raw to the shape expected by the Go type t, where real-world
+// JSON-LD commonly differs from it: a single value for a slice, an array for a single object,
+// a number for a string and a numeric string for a number. Other values are returned unchanged.
+func normalize(t reflect.Type, raw json.RawMessage) json.RawMessage {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return raw
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		items := []json.RawMessage{raw}
+		if raw[0] == '[' {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return raw
+			}
+		}
+		for i := range items {
+			items[i] = normalize(t.Elem(), items[i])
+		}
+		return remarshal(items, raw)
+
+	case reflect.Struct:
+		if raw[0] == '[' {
+			var items []json.RawMessage
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return raw
+			}
+			if len(items) == 0 {
+				return json.RawMessage("null")
+			}
+			return normalize(t, items[0])
+		}
+		var fields map[string]json.RawMessage
+		if raw[0] != '{' || json.Unmarshal(raw, &fields) != nil {
+			return raw
+		}
+		for name, ft := range jsonFields(t) {
+			if value, ok := fields[name]; ok {
+				fields[name] = normalize(ft, value)
+			}
+		}
+		return remarshal(fields, raw)
+
+	case reflect.String:
+		if raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9') {
+			return remarshal(string(raw), raw)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		var s string
+		if raw[0] != '"' || json.Unmarshal(raw, &s) != nil {
+			return raw
+		}
+		if s = strings.TrimSpace(s); s == "" {
+			return json.RawMessage("null")
+		}
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			return json.RawMessage(s)
+		}
+	}
+	return raw
+}
+
+// jsonFields returns the Go types of the fields of the struct type t by JSON name,
+// including the fields of embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for embedded, ft := range jsonFields(field.Type) {
+				fields[embedded] = ft
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// remarshal encodes v, falling back to raw when v cannot be encoded.
+func remarshal(v any, raw json.RawMessage) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return raw
+	}
+	return data
+}
+
+// decodeTypes decodes a @type value holding a string or an array of strings.
+func decodeTypes(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}, nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(raw, &multiple); err != nil {
+		return nil, fmt.Errorf("invalid @type: %s", raw)
+	}
+	return multiple, nil
+}
@@ -0,0 +1,211 @@
+package schemaorg
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestUnmarshalGraph tests the dispatch on @type for @graph documents, @type arrays and unknown types
+func TestUnmarshalGraph(t *testing.T) {
+	data := []byte(`{
+		"@context": "https://schema.org",
+		"@graph": [
+			{"@type": "Organization", "name": "Example Inc."},
+			{"@type": ["Product", "Thing"], "name": "Example Product", "offers": {"@type": "Offer", "price": "29.99"}},
+			{"@type": "Restaurant", "name": "Example Restaurant"},
+			{"@type": "Recipe", "name": "Pizza"}
+		]
+	}`)
+
+	entities, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(entities) != 4 {
+		t.Fatalf("expected 4 entities, got %d", len(entities))
+	}
+
+	if org, ok := entities[0].(*Organization); !ok || org.Name != "Example Inc." {
+		t.Errorf("expected an *Organization, got %#v", entities[0])
+	}
+	if product, ok := entities[1].(*Product); !ok || product.Type != "Product" || product.Offers.Price != "29.99" {
+		t.Errorf("expected a *Product, got %#v", entities[1])
+	}
+	if business, ok := entities[2].(*LocalBusiness); !ok || business.Type != "Restaurant" {
+		t.Errorf("expected a *LocalBusiness keeping its Restaurant type, got %#v", entities[2])
+	}
+	if generic, ok := entities[3].(*Generic); !ok || generic.Types[0] != "Recipe" {
+		t.Errorf("expected a *Generic, got %#v", entities[3])
+	}
+}
+
+// TestUnmarshalArray tests a document holding an array of entities
+func TestUnmarshalArray(t *testing.T) {
+	entities, err := Unmarshal([]byte(`[{"@type": "Event", "name": "Example"}, {"@type": "WebPage", "name": "Example"}]`))
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if _, ok := entities[0].(*Event); !ok {
+		t.Errorf("expected an *Event, got %T", entities[0])
+	}
+	if _, ok := entities[1].(*WebPage); !ok {
+		t.Errorf("expected a *WebPage, got %T", entities[1])
+	}
+
+	if _, err := Unmarshal([]byte(`[{"@type": "Product"`)); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+// TestUnmarshalShapes tests the common variations of real-world JSON-LD properties
+func TestUnmarshalShapes(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		check func(Entity) bool
+	}{
+		{
+			name: "image as string",
+			data: `{"@type": "Product", "image": "https://www.example.com/1.jpg"}`,
+			check: func(e Entity) bool {
+				p, ok := e.(*Product)
+				return ok && len(p.Image) == 1 && p.Image[0] == "https://www.example.com/1.jpg"
+			},
+		},
+		{
+			name: "offers as array",
+			data: `{"@type": "Product", "offers": [{"@type": "Offer", "price": "29.99"}, {"@type": "Offer", "price": "39.99"}]}`,
+			check: func(e Entity) bool {
+				p, ok := e.(*Product)
+				return ok && p.Offers != nil && p.Offers.Price == "29.99"
+			},
+		},
+		{
+			name: "author as array",
+			data: `{"@type": "Article", "author": [{"@type": "Person", "name": "Jane Doe"}, {"@type": "Person", "name": "John Doe"}]}`,
+			check: func(e Entity) bool {
+				a, ok := e.(*Article)
+				return ok && a.Author != nil && a.Author.Name == "Jane Doe"
+			},
+		},
+		{
+			name: "empty author array",
+			data: `{"@type": "Article", "headline": "Example", "author": []}`,
+			check: func(e Entity) bool {
+				a, ok := e.(*Article)
+				return ok && a.Author == nil && a.Headline == "Example"
+			},
+		},
+		{
+			name: "numeric price",
+			data: `{"@type": "Product", "offers": {"@type": "Offer", "price": 29.99}}`,
+			check: func(e Entity) bool {
+				p, ok := e.(*Product)
+				return ok && p.Offers != nil && p.Offers.Price == "29.99"
+			},
+		},
+		{
+			name: "string ratingValue",
+			data: `{"@type": "Product", "aggregateRating": {"@type": "AggregateRating", "ratingValue": "4.5", "reviewCount": "12"}}`,
+			check: func(e Entity) bool {
+				p, ok := e.(*Product)
+				return ok && p.AggregateRating != nil && p.AggregateRating.RatingValue == 4.5 && p.AggregateRating.ReviewCount == 12
+			},
+		},
+		{
+			name: "nested review rating",
+			data: `{"@type": "Product", "review": {"@type": "Review", "reviewRating": {"@type": "Rating", "ratingValue": "5"}}}`,
+			check: func(e Entity) bool {
+				p, ok := e.(*Product)
+				return ok && len(p.Review) == 1 && p.Review[0].ReviewRating.RatingValue == 5
+			},
+		},
+		{
+			name: "mismatch falls back to Generic",
+			data: `{"@type": "Product", "name": {"@value": "Example"}}`,
+			check: func(e Entity) bool {
+				g, ok := e.(*Generic)
+				return ok && g.Types[0] == "Product" && string(g.Raw) == `{"@type": "Product", "name": {"@value": "Example"}}`
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entities, err := Unmarshal([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if len(entities) != 1 || !tt.check(entities[0]) {
+				t.Errorf("unexpected entity: %#v", entities)
+			}
+		})
+	}
+}
+
+// TestUnmarshalFallback tests that an entity not fitting its struct does not abort the document
+func TestUnmarshalFallback(t *testing.T) {
+	entities, err := Unmarshal([]byte(`[
+		{"@type": "Organization", "name": "Example Inc."},
+		{"@type": "Event", "startDate": {"@type": "Date"}},
+		{"@type": "WebSite", "name": "Example"}
+	]`))
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(entities) != 3 {
+		t.Fatalf("expected 3 entities, got %d", len(entities))
+	}
+	if _, ok := entities[1].(*Generic); !ok {
+		t.Errorf("expected a *Generic, got %T", entities[1])
+	}
+	if ws, ok := entities[2].(*WebSite); !ok || ws.Name != "Example" {
+		t.Errorf("expected a *WebSite, got %#v", entities[2])
+	}
+}
+
+// TestGenericContext tests that a Generic split from a @graph or an array renders with a @context
+func TestGenericContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "graph context",
+			data:     `{"@context": "https://schema.org/", "@graph": [{"@type": "Recipe", "name": "Pizza"}]}`,
+			expected: `{"@context":"https://schema.org/","@type":"Recipe","name":"Pizza"}`,
+		},
+		{
+			name:     "array without context",
+			data:     `[{"@type": "Recipe", "name": "Pizza"}]`,
+			expected: `{"@context":"https://schema.org","@type":"Recipe","name":"Pizza"}`,
+		},
+		{
+			name:     "own context",
+			data:     `{"@context": "https://schema.org", "@graph": [{"@context": {"@vocab": "https://schema.org/"}, "@type": "Recipe"}]}`,
+			expected: `{"@context":{"@vocab":"https://schema.org/"},"@type":"Recipe"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entities, err := Unmarshal([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			generic, ok := entities[0].(*Generic)
+			if !ok {
+				t.Fatalf("expected a *Generic, got %T", entities[0])
+			}
+
+			var sb strings.Builder
+			if err := generic.Render(&sb); err != nil {
+				t.Fatalf("Render failed: %v", err)
+			}
+			if !strings.Contains(sb.String(), tt.expected) {
+				t.Errorf("expected %s in the output, got %s", tt.expected, sb.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package schemaorg
+
+import (
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+// hasRule reports whether issues contains an issue with the rule id and severity
+func hasRule(issues []teseo.Issue, rule string, severity teseo.Severity) bool {
+	for _, issue := range issues {
+		if issue.Rule == rule && issue.Severity == severity {
+			return true
+		}
+	}
+	return false
+}
+
+// TestEntitiesImplementValidator tests that every entity exposes Validate
+func TestEntitiesImplementValidator(t *testing.T) {
+	for name, entity := range sharedEntities() {
+		if _, ok := entity.(teseo.Validator); !ok {
+			t.Errorf("%s does not implement teseo.Validator", name)
+		}
+	}
+}
+
+// TestProductValidate tests the Product rules
+func TestProductValidate(t *testing.T) {
+	issues := (&Product{Name: "Example", Image: []string{"not-a-url"}}).Validate()
+
+	if !hasRule(issues, "schemaorg.Product.offers.oneOf", teseo.SeverityError) {
+		t.Errorf("expected an error for missing offers, review and aggregateRating, got %v", issues)
+	}
+	if !hasRule(issues, "schemaorg.Product.image.url", teseo.SeverityError) {
+		t.Errorf("expected an error for a malformed image URL, got %v", issues)
+	}
+	if !hasRule(issues, "schemaorg.Product.brand.recommended", teseo.SeverityWarning) {
+		t.Errorf("expected a warning for a missing brand, got %v", issues)
+	}
+
+	valid := &Product{
+		Name:        "Example",
+		Description: "Example product",
+		Image:       []string{"https://www.example.com/product.jpg"},
+		SKU:         "12345",
+		Brand:       &Brand{Name: "Example Brand"},
+		Offers:      &Offer{Price: "29.99", PriceCurrency: "USD", Availability: "https://schema.org/InStock"},
+	}
+	if issues := valid.Validate(); len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+}
+
+// TestNestedIssueFields tests the field paths and rule ids of nested objects
+func TestNestedIssueFields(t *testing.T) {
+	product := &Product{
+		Name:   "Example",
+		Review: []*Review{{ReviewRating: &Rating{RatingValue: 4}, DatePublished: "yesterday"}},
+	}
+
+	for _, issue := range product.Validate() {
+		if issue.Rule == "schemaorg.Product.review.datePublished.date" {
+			if issue.Field != "review[0].datePublished" {
+				t.Errorf("expected field review[0].datePublished, got %q", issue.Field)
+			}
+			return
+		}
+	}
+	t.Error("expected an error for a malformed review date")
+}
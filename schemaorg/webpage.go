@@ -1,13 +1,12 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // WebPage represents a Schema.org WebPage object.
@@ -67,7 +66,8 @@ import (
 //		"keywords": "example, webpage, demo"
 //	}
 type WebPage struct {
-	Context       string `json:"@context"`
+	Context       string `json:"@context,omitempty"`
+	ID            string `json:"@id,omitempty"`
 	Type          string `json:"@type"`
 	URL           string `json:"url,omitempty"`
 	Name          string `json:"name,omitempty"`
@@ -104,31 +104,54 @@ func NewWebPage(url string, name string, headline string, description string, ab
 
 // ToJsonLd converts the WebPage struct to a JSON-LD `templ.Component`.
 func (wp *WebPage) ToJsonLd() templ.Component {
-	wp.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "webpage", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, wp).WithType("application/ld+json")
+	return newDocument("webpage", wp.Context, wp).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the WebSite struct as `template.HTML` value for Go's `html/template`.
 func (wp *WebPage) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := wp.ToJsonLd()
+	return teseo.RenderGoHTML("schemaorg.WebPage", wp.ToJsonLd())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
+// Render writes the WebPage JSON-LD script to w, without going through templ.
+func (wp *WebPage) Render(w io.Writer) error {
+	return newDocument("webpage", wp.Context, wp).Render(w)
+}
+
+// MergeSite fills the empty inLanguage, isPartOf and primaryImageOfPage with the Site defaults.
+func (wp *WebPage) MergeSite(site *teseo.Site) {
+	if site == nil {
+		return
 	}
+	fill(&wp.InLanguage, site.Language())
+	fill(&wp.IsPartOf, site.URL)
+	fill(&wp.PrimaryImage, site.Image)
+}
 
-	return html, nil
+// Validate checks the WebPage properties.
+func (wp *WebPage) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.WebPage")
+	v.Recommended("url", wp.URL != "")
+	v.Recommended("name", validate.NotEmpty(wp.Name))
+	v.Recommended("description", validate.NotEmpty(wp.Description))
+	v.URL("url", wp.URL)
+	v.URL("isPartOf", wp.IsPartOf)
+	v.URL("primaryImageOfPage", wp.PrimaryImage)
+	v.Date("lastReviewed", wp.LastReviewed)
+	v.Date("datePublished", wp.DatePublished)
+	v.Date("dateModified", wp.DateModified)
+	return v.Issues()
 }
 
 func (wp *WebPage) ensureDefaults() {
-	if wp.Context == "" {
-		wp.Context = "https://schema.org"
-	}
-
 	if wp.Type == "" {
 		wp.Type = "WebPage"
 	}
 }
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the WebPage.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (wp WebPage) MarshalJSON() ([]byte, error) {
+	type alias WebPage
+	wp.ensureDefaults()
+	return marshalEntity(alias(wp))
+}
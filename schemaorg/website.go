@@ -1,13 +1,13 @@
 package schemaorg
 
 import (
-	"context"
-	"fmt"
+	"encoding/json"
 	"html/template"
-	"log"
+	"io"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // WebSite represents a Schema.org WebSite object.
@@ -69,7 +69,8 @@ type Action struct {
 
 // WebSite represents a Schema.org WebSite object
 type WebSite struct {
-	Context         string  `json:"@context"`
+	Context         string  `json:"@context,omitempty"`
+	ID              string  `json:"@id,omitempty"`
 	Type            string  `json:"@type"`
 	URL             string  `json:"url,omitempty"`
 	Name            string  `json:"name,omitempty"`
@@ -92,47 +93,73 @@ func NewWebSite(url string, name string, alternateName string, description strin
 
 // ToJsonLd converts the WebSite struct to a JSON-LD `templ.Component`.
 func (ws *WebSite) ToJsonLd() templ.Component {
-	ws.ensureDefaults()
-	id := fmt.Sprintf("%s-%s", "website", teseo.GenerateUniqueKey())
-	return templ.JSONScript(id, ws).WithType("application/ld+json")
+	return newDocument("website", ws.Context, ws).ToJsonLd()
 }
 
 // ToGoHTMLJsonLd renders the WebSite struct as `template.HTML` value for Go's `html/template`.
 func (ws *WebSite) ToGoHTMLJsonLd() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := ws.ToJsonLd()
+	return teseo.RenderGoHTML("schemaorg.WebSite", ws.ToJsonLd())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the WebSite JSON-LD script to w, without going through templ.
+func (ws *WebSite) Render(w io.Writer) error {
+	return newDocument("website", ws.Context, ws).Render(w)
+}
 
-	return html, nil
+// MergeSite fills the empty name and url with the Site defaults.
+func (ws *WebSite) MergeSite(site *teseo.Site) {
+	if site == nil {
+		return
+	}
+	fill(&ws.Name, site.Name)
+	fill(&ws.URL, site.URL)
 }
 
-func (ws *WebSite) ensureDefaults() {
-	if ws.Context == "" {
-		ws.Context = "https://schema.org"
+// Validate checks the WebSite against the Google guidelines for site names.
+// For more details see: https://developers.google.com/search/docs/appearance/site-names
+func (ws *WebSite) Validate() []teseo.Issue {
+	v := validate.New("schemaorg.WebSite")
+	v.Required("name", validate.NotEmpty(ws.Name))
+	v.Required("url", ws.URL != "")
+	v.URL("url", ws.URL)
+
+	if ws.PotentialAction != nil {
+		av := v.Child("potentialAction")
+		av.Required("target", ws.PotentialAction.Target != nil)
+		av.Required("query-input", ws.PotentialAction.QueryInput != "")
+		if ws.PotentialAction.Target != nil {
+			av.Child("target").Required("urlTemplate", ws.PotentialAction.Target.URLTemplate != "")
+		}
 	}
 
-	if ws.Context == "" {
+	return v.Issues()
+}
+
+func (ws *WebSite) ensureDefaults() {
+	if ws.Type == "" {
 		ws.Type = "WebSite"
 	}
+}
 
-	if ws.PotentialAction != nil {
-		ws.PotentialAction.ensureDefaults()
-	}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the WebSite.
+// @context defaults to DefaultContext and is dropped when the entity is nested in another one.
+func (ws WebSite) MarshalJSON() ([]byte, error) {
+	type alias WebSite
+	ws.ensureDefaults()
+	return marshalEntity(alias(ws))
 }
 
 func (act *Action) ensureDefaults() {
 	if act.Type == "" {
 		act.Type = "Action"
 	}
+}
 
-	if act.Target != nil {
-		act.Target.ensureDefaults()
-	}
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Action.
+func (act Action) MarshalJSON() ([]byte, error) {
+	type alias Action
+	act.ensureDefaults()
+	return json.Marshal(alias(act))
 }
 
 func (tgt *Target) ensureDefaults() {
@@ -140,3 +167,10 @@ func (tgt *Target) ensureDefaults() {
 		tgt.Type = "EntryPoint"
 	}
 }
+
+// MarshalJSON implements json.Marshaler, applying the default values to a copy of the Target.
+func (tgt Target) MarshalJSON() ([]byte, error) {
+	type alias Target
+	tgt.ensureDefaults()
+	return json.Marshal(alias(tgt))
+}
@@ -0,0 +1,139 @@
+package teseo
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Site holds the site-wide defaults shared by every page, e.g. the site name, the default share
+// image or the publisher organization. Merge fills the empty fields of the page values with them,
+// page values always win.
+//
+// Example usage:
+//
+//	var site = &teseo.Site{
+//		Name:          "Example",
+//		URL:           "https://www.example.com",
+//		Locale:        "en_US",
+//		Image:         "https://www.example.com/share.jpg",
+//		TwitterSite:   "@example",
+//		TitleTemplate: "%s | Example",
+//		Publisher: &teseo.Publisher{
+//			Name: "Example Inc.",
+//			URL:  "https://www.example.com",
+//			Logo: "https://www.example.com/logo.png",
+//		},
+//	}
+//
+//	// In the handler
+//	article := &opengraph.Article{OpenGraphObject: opengraph.OpenGraphObject{Title: "Example Article"}}
+//	card := &twittercard.TwitterCard{Title: "Example Article"}
+//	site.Merge(article, card)
+type Site struct {
+	Name          string     // Name of the site, e.g. og:site_name
+	URL           string     // URL of the home page
+	Locale        string     // Default locale, e.g. "en_US"
+	Image         string     // URL of the default share image, e.g. og:image and twitter:image
+	TwitterSite   string     // Twitter/X handle of the site, e.g. "@example"
+	TitleTemplate string     // Format of the page titles, e.g. "%s | Example", applied to og:title and twitter:title too; the page title is used as is when empty or without %s
+	Publisher     *Publisher // Publisher organization of the JSON-LD entities
+}
+
+// Publisher represents the organization publishing the site content.
+// It fills the empty publisher of the Schema.org entities, e.g. schemaorg.Article.
+type Publisher struct {
+	Name   string   // Name of the organization
+	URL    string   // URL of the organization
+	Logo   string   // URL of the logo
+	SameAs []string // URLs of the organization profiles, e.g. social networks
+}
+
+// SiteMerger is the interface implemented by values filling their empty fields from the Site defaults.
+// It is implemented by every Open Graph type, the Twitter Card and the Schema.org entities with site-wide properties.
+type SiteMerger interface {
+	MergeSite(site *Site)
+}
+
+// Merge fills the empty fields of every item implementing SiteMerger with the Site defaults.
+// Other items, as well as nil values, are ignored. Merge modifies the items: call it on the values
+// built for the page, not on values shared across requests, which Merged leaves unchanged.
+func (s *Site) Merge(items ...any) {
+	if s == nil {
+		return
+	}
+	for _, item := range items {
+		if isNil(item) {
+			continue
+		}
+		if merger, ok := item.(SiteMerger); ok {
+			merger.MergeSite(s)
+		}
+	}
+}
+
+// Merged returns a copy of item with the empty fields filled with the Site defaults, leaving item
+// unchanged, so values shared across requests can be merged concurrently. The copy is shallow;
+// SiteMerger values holding other values to merge, e.g. schemaorg.Graph, merge copies of them.
+// Items not implementing SiteMerger with a pointer to a struct, as well as nil values, are returned as is.
+func (s *Site) Merged(item any) any {
+	if s == nil || isNil(item) {
+		return item
+	}
+	if _, ok := item.(SiteMerger); !ok {
+		return item
+	}
+	v := reflect.ValueOf(item)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return item
+	}
+	clone := reflect.New(v.Elem().Type())
+	clone.Elem().Set(v.Elem())
+	merger := clone.Interface().(SiteMerger)
+	merger.MergeSite(s)
+	return merger
+}
+
+// Title returns the page title formatted with the TitleTemplate, e.g. "About | Example".
+// The site name is returned for an empty page title. The page title is returned as is when
+// the TitleTemplate is empty or has no `%s` placeholder; other `%` characters are kept as is.
+func (s *Site) Title(page string) string {
+	switch {
+	case s == nil:
+		return page
+	case page == "":
+		return s.Name
+	case !strings.Contains(s.TitleTemplate, "%s"):
+		return page
+	}
+	return strings.Replace(s.TitleTemplate, "%s", page, 1)
+}
+
+// MergedTitle keeps the title of a value before and after its formatting by Site.MergeTitle.
+// The zero value is ready to use.
+type MergedTitle struct {
+	raw    string
+	merged string
+}
+
+// MergeTitle sets *title to the title formatted with the TitleTemplate, see Title. The title before
+// formatting is kept in state: merging again formats that title, not the result of the previous merge,
+// so merging the same Site twice has no further effect. A title changed since is formatted as is.
+func (s *Site) MergeTitle(title *string, state *MergedTitle) {
+	if s == nil {
+		return
+	}
+	if state.merged != "" && *title == state.merged {
+		*title = state.raw
+	}
+	state.raw = *title
+	*title = s.Title(*title)
+	state.merged = *title
+}
+
+// Language returns the Locale as a BCP 47 language tag, e.g. "en-US" for "en_US".
+func (s *Site) Language() string {
+	if s == nil {
+		return ""
+	}
+	return strings.ReplaceAll(s.Locale, "_", "-")
+}
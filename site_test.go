@@ -0,0 +1,145 @@
+package teseo_test
+
+import (
+	"testing"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+	"github.com/indaco/teseo/schemaorg"
+	"github.com/indaco/teseo/twittercard"
+)
+
+// TestSiteMerge tests that the site defaults fill the empty fields and never override page values
+func TestSiteMerge(t *testing.T) {
+	site := &teseo.Site{
+		Name:          "Example",
+		URL:           "https://www.example.com",
+		Locale:        "en_US",
+		Image:         "https://www.example.com/share.jpg",
+		TwitterSite:   "@example",
+		TitleTemplate: "%s | Example",
+		Publisher:     &teseo.Publisher{Name: "Example Inc.", Logo: "https://www.example.com/logo.png"},
+	}
+
+	og := &opengraph.Article{OpenGraphObject: opengraph.OpenGraphObject{Title: "Page", Image: "https://www.example.com/page.jpg"}}
+	card := &twittercard.TwitterCard{Title: "Page"}
+	article := &schemaorg.Article{Headline: "Page"}
+	page := &schemaorg.WebPage{Name: "Page", InLanguage: "it-IT"}
+	var missing *schemaorg.Product
+
+	graph := schemaorg.NewGraph("", article, page)
+	site.Merge(og, card, graph, missing, "unsupported")
+	if article.Publisher != nil || page.IsPartOf != "" {
+		t.Errorf("merging the graph modified its entities: %+v, %+v", article, page)
+	}
+	article, page = graph.Entities[0].(*schemaorg.Article), graph.Entities[1].(*schemaorg.WebPage)
+
+	if og.Image != "https://www.example.com/page.jpg" || og.SiteName != "Example" || og.Locale != "en_US" {
+		t.Errorf("unexpected Open Graph defaults: %+v", og.OpenGraphObject)
+	}
+	if card.Site != "@example" || card.Image != site.Image {
+		t.Errorf("unexpected Twitter Card defaults: %+v", card)
+	}
+	if article.Publisher == nil || article.Publisher.Name != "Example Inc." || article.Publisher.Logo.URL != site.Publisher.Logo {
+		t.Errorf("expected the site publisher, got %+v", article.Publisher)
+	}
+	if len(article.Image) != 1 || article.Image[0] != site.Image {
+		t.Errorf("expected the default image, got %v", article.Image)
+	}
+	if page.InLanguage != "it-IT" || page.IsPartOf != site.URL {
+		t.Errorf("unexpected WebPage defaults: %+v", page)
+	}
+
+	if got := site.Title("About"); got != "About | Example" {
+		t.Errorf("expected formatted title, got %q", got)
+	}
+	if got := site.Title(""); got != "Example" {
+		t.Errorf("expected the site name, got %q", got)
+	}
+}
+
+// TestSiteMergeTitle tests that the title template formats the social titles once
+func TestSiteMergeTitle(t *testing.T) {
+	site := &teseo.Site{Name: "Example", TitleTemplate: "%s | Example"}
+
+	og := &opengraph.WebSite{OpenGraphObject: opengraph.OpenGraphObject{Title: "About"}}
+	card := &twittercard.TwitterCard{Title: "About"}
+	untitled := &opengraph.WebSite{}
+
+	site.Merge(og, card, untitled)
+	site.Merge(og, card, untitled)
+
+	if og.Title != "About | Example" {
+		t.Errorf("expected formatted og:title, got %q", og.Title)
+	}
+	if card.Title != "About | Example" {
+		t.Errorf("expected formatted twitter:title, got %q", card.Title)
+	}
+	if untitled.Title != "" {
+		t.Errorf("expected empty og:title to stay empty, got %q", untitled.Title)
+	}
+
+	if got := (&teseo.Site{TitleTemplate: "%s"}).Title("About"); got != "About" {
+		t.Errorf("expected bare template to keep the title, got %q", got)
+	}
+}
+
+// TestSiteTitleTemplate tests the titles equal to the site name or looking formatted, and invalid templates
+func TestSiteTitleTemplate(t *testing.T) {
+	site := &teseo.Site{Name: "Example", TitleTemplate: "%s | Example"}
+
+	home := &opengraph.WebSite{OpenGraphObject: opengraph.OpenGraphObject{Title: "Example"}}
+	tips := &twittercard.TwitterCard{Title: "Tips | Example"}
+	site.Merge(home, tips)
+	site.Merge(home, tips)
+	if home.Title != "Example | Example" || tips.Title != "Tips | Example | Example" {
+		t.Errorf("expected the titles formatted once, got %q and %q", home.Title, tips.Title)
+	}
+
+	other := &teseo.Site{Name: "Other", TitleTemplate: "Other - %s"}
+	other.Merge(home)
+	if home.Title != "Other - Example" {
+		t.Errorf("expected the original title formatted with the other site, got %q", home.Title)
+	}
+	home.Title = "Home"
+	other.Merge(home)
+	if home.Title != "Other - Home" {
+		t.Errorf("expected the changed title formatted, got %q", home.Title)
+	}
+
+	tests := map[string]string{
+		"Example":           "About",
+		"%d | Example":      "About",
+		"%s | 100% Example": "About | 100% Example",
+	}
+	for template, expected := range tests {
+		if got := (&teseo.Site{TitleTemplate: template}).Title("About"); got != expected {
+			t.Errorf("expected %q for template %q, got %q", expected, template, got)
+		}
+	}
+}
+
+// TestSiteMerged tests that Merged fills a copy and leaves the value unchanged
+func TestSiteMerged(t *testing.T) {
+	site := &teseo.Site{Name: "Example", TwitterSite: "@example"}
+	card := &twittercard.TwitterCard{Title: "Page"}
+
+	merged, ok := site.Merged(card).(*twittercard.TwitterCard)
+	if !ok || merged == card {
+		t.Fatalf("expected a copy of the Twitter Card, got %#v", merged)
+	}
+	if merged.Site != "@example" || merged.Title != "Page" {
+		t.Errorf("unexpected merged Twitter Card: %+v", merged)
+	}
+	if card.Site != "" {
+		t.Errorf("Merged modified the Twitter Card: %+v", card)
+	}
+
+	var missing *schemaorg.Product
+	if got := site.Merged(missing); got != missing {
+		t.Errorf("expected nil value as is, got %#v", got)
+	}
+	if got := site.Merged("unsupported"); got != "unsupported" {
+		t.Errorf("expected unsupported value as is, got %#v", got)
+	}
+}
@@ -0,0 +1,79 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+	"github.com/indaco/teseo/links"
+)
+
+// XHTMLNamespace is the namespace of the `<xhtml:link>` elements declaring the language alternates of a URL.
+// For more details see: https://developers.google.com/search/docs/specialty/international/localized-versions#sitemap
+const XHTMLNamespace = "http://www.w3.org/1999/xhtml"
+
+// xhtmlLink is the XML representation of a language alternate.
+type xhtmlLink struct {
+	Rel      string `xml:"rel,attr"`
+	Hreflang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+// MarshalXML encodes the link as `<xhtml:link>` element.
+func (l xhtmlLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type link xhtmlLink // same fields, without the MarshalXML method
+	return e.EncodeElement(link(l), xml.StartElement{Name: xml.Name{Local: "xhtml:link"}})
+}
+
+// alternateLinks returns the `<xhtml:link>` elements of the alternates with an href.
+func alternateLinks(alternates []links.Alternate) []xhtmlLink {
+	var result []xhtmlLink
+	for _, alt := range alternates {
+		if alt.Href != "" {
+			result = append(result, xhtmlLink{Rel: "alternate", Hreflang: alt.Hreflang, Href: alt.Href})
+		}
+	}
+	return result
+}
+
+// alternates returns the language alternates of the `<xhtml:link rel="alternate">` elements.
+func alternates(xhtmlLinks []xhtmlLink) []links.Alternate {
+	var result []links.Alternate
+	for _, l := range xhtmlLinks {
+		if strings.EqualFold(strings.TrimSpace(l.Rel), "alternate") && l.Hreflang != "" {
+			result = append(result, links.Alternate{Hreflang: strings.TrimSpace(l.Hreflang), Href: strings.TrimSpace(l.Href)})
+		}
+	}
+	return result
+}
+
+// validateAlternates checks the language alternates of every URL and that the alternates are
+// reciprocal: every alternate listed in the sitemap must list back the URL referencing it.
+// An alternate which is not a URL of the sitemap may be listed by another sitemap, it is a warning.
+func validateAlternates(v *validate.Validator, urls []URL) {
+	cluster := map[string][]validate.Alternate{}
+	for _, u := range urls {
+		if u.Loc != "" {
+			cluster[u.Loc] = u.alternates()
+		}
+	}
+
+	for i, u := range urls {
+		if len(u.Alternates) == 0 {
+			continue
+		}
+		uv := v.Index("urls", i)
+		uv.Alternates("alternates", u.Loc, u.alternates())
+		uv.Reciprocal("alternates", u.Loc, u.alternates(), cluster, teseo.SeverityWarning)
+	}
+}
+
+// alternates returns the language alternates of the URL as checked by the validate package.
+func (u URL) alternates() []validate.Alternate {
+	result := make([]validate.Alternate, len(u.Alternates))
+	for i, alt := range u.Alternates {
+		result[i] = validate.Alternate(alt)
+	}
+	return result
+}
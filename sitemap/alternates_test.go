@@ -0,0 +1,83 @@
+package sitemap
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo/links"
+)
+
+const sampleAlternates = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:xhtml="http://www.w3.org/1999/xhtml">
+  <url>
+    <loc>https://www.example.com/en/about</loc>
+    <xhtml:link rel="alternate" hreflang="en" href="https://www.example.com/en/about"></xhtml:link>
+    <xhtml:link rel="alternate" hreflang="de" href="https://www.example.com/de/about"></xhtml:link>
+    <xhtml:link rel="alternate" hreflang="x-default" href="https://www.example.com/en/about"></xhtml:link>
+  </url>
+  <url>
+    <loc>https://www.example.com/de/about</loc>
+    <xhtml:link rel="alternate" hreflang="en" href="https://www.example.com/en/about"></xhtml:link>
+    <xhtml:link rel="alternate" hreflang="de" href="https://www.example.com/de/about"></xhtml:link>
+    <xhtml:link rel="alternate" hreflang="x-default" href="https://www.example.com/en/about"></xhtml:link>
+  </url>
+</urlset>`
+
+// localized returns the URL loc listing the English, German and default alternates.
+func localized(loc string) URL {
+	return URL{Loc: loc, Alternates: []links.Alternate{
+		{Hreflang: "en", Href: "https://www.example.com/en/about"},
+		{Hreflang: "de", Href: "https://www.example.com/de/about"},
+		{Hreflang: links.XDefault, Href: "https://www.example.com/en/about"},
+	}}
+}
+
+// TestAlternates tests that the alternates are written in the xhtml namespace and parsed back
+func TestAlternates(t *testing.T) {
+	sm := New(localized("https://www.example.com/en/about"), localized("https://www.example.com/de/about"))
+
+	var buf bytes.Buffer
+	if err := sm.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if buf.String() != sampleAlternates {
+		t.Errorf("expected\n%s\ngot\n%s", sampleAlternates, buf.String())
+	}
+
+	read := &Sitemap{}
+	if err := read.Read(strings.NewReader(sampleAlternates)); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if !reflect.DeepEqual(read.URLs, sm.URLs) {
+		t.Errorf("expected %+v, got %+v", sm.URLs, read.URLs)
+	}
+	if issues := read.Validate(); len(issues) != 0 {
+		t.Errorf("expected no issues, got %+v", issues)
+	}
+}
+
+// TestValidateAlternates tests the reciprocity and the x-default rules
+func TestValidateAlternates(t *testing.T) {
+	en := localized("https://www.example.com/en/about")
+	en.Alternates = append(en.Alternates, links.Alternate{Hreflang: "fr", Href: "https://www.example.com/fr/about"})
+	de := URL{Loc: "https://www.example.com/de/about", Alternates: []links.Alternate{
+		{Hreflang: "de", Href: "https://www.example.com/de/about"},
+		{Hreflang: "de-UK", Href: "https://www.example.com/de/about"},
+	}}
+
+	var rules []string
+	for _, issue := range New(en, de).Validate() {
+		rules = append(rules, issue.Rule+" "+issue.Field)
+	}
+	expected := []string{
+		"sitemap.Sitemap.urls.alternates.href.reciprocal urls[0].alternates[1].href",
+		"sitemap.Sitemap.urls.alternates.href.cluster urls[0].alternates[3].href",
+		"sitemap.Sitemap.urls.alternates.hreflang.language urls[1].alternates[1].hreflang",
+		"sitemap.Sitemap.urls.alternates.xDefault urls[1].alternates",
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("expected %v, got %v", expected, rules)
+	}
+}
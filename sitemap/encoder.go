@@ -0,0 +1,174 @@
+package sitemap
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// closeURLSet is the end of a `<urlset>` document.
+const closeURLSet = "\n</urlset>"
+
+// urlsetEncoder writes a `<urlset>` document one URL at a time, keeping track of its size.
+type urlsetEncoder struct {
+	w     io.Writer
+	size  int64 // bytes written so far
+	count int   // URLs written so far
+}
+
+// extensions is a set of sitemap extensions, declared as namespaces of the `<urlset>` element.
+type extensions uint8
+
+const (
+	extImage extensions = 1 << iota
+	extVideo
+	extNews
+	extXHTML
+)
+
+// extensionNamespaces lists the prefix and the namespace of the extensions, in declaration order.
+var extensionNamespaces = []struct {
+	ext       extensions
+	prefix    string
+	namespace string
+}{
+	{extImage, "image", ImageNamespace},
+	{extVideo, "video", VideoNamespace},
+	{extNews, "news", NewsNamespace},
+	{extXHTML, "xhtml", XHTMLNamespace},
+}
+
+// extensions returns the extensions used by the URL.
+func (u URL) extensions() extensions {
+	var ext extensions
+	if len(u.Images) > 0 {
+		ext |= extImage
+	}
+	if len(u.Videos) > 0 {
+		ext |= extVideo
+	}
+	if u.News != nil {
+		ext |= extNews
+	}
+	if len(u.Alternates) > 0 {
+		ext |= extXHTML
+	}
+	return ext
+}
+
+// newURLSetEncoder writes the XML declaration and the `<urlset>` start tag declaring the namespaces of ext to w.
+func newURLSetEncoder(w io.Writer, ext extensions) (*urlsetEncoder, error) {
+	e := &urlsetEncoder{w: w}
+	start := xml.Header + `<urlset xmlns="` + Namespace + `"`
+	for _, ns := range extensionNamespaces {
+		if ext&ns.ext != 0 {
+			start += ` xmlns:` + ns.prefix + `="` + ns.namespace + `"`
+		}
+	}
+	if err := e.write([]byte(start + ">")); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// encodeURL returns the indented `<url>` element of u, preceded by a line break, using buf.
+// The slice is only valid until the next use of buf.
+func encodeURL(buf *bytes.Buffer, u URL) ([]byte, error) {
+	buf.Reset()
+	buf.WriteByte('\n')
+	enc := xml.NewEncoder(buf)
+	enc.Indent("  ", "  ")
+	if err := enc.EncodeElement(u, xml.StartElement{Name: xml.Name{Local: "url"}}); err != nil {
+		return nil, fmt.Errorf("failed to encode sitemap URL %q: %w", u.Loc, err)
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to encode sitemap URL %q: %w", u.Loc, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// add writes an element returned by encodeURL.
+func (e *urlsetEncoder) add(element []byte) error {
+	if err := e.write(element); err != nil {
+		return err
+	}
+	e.count++
+	return nil
+}
+
+// close writes the `</urlset>` end tag.
+func (e *urlsetEncoder) close() error {
+	return e.write([]byte(closeURLSet))
+}
+
+func (e *urlsetEncoder) write(b []byte) error {
+	n, err := e.w.Write(b)
+	e.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write sitemap: %w", err)
+	}
+	return nil
+}
+
+// decodeElements decodes the XML document read from r, checking its root element and calling
+// decode for each child element named child. Gzip compressed documents are decompressed.
+func decodeElements(r io.Reader, root, child string, decode func(dec *xml.Decoder, start xml.StartElement) error) error {
+	r, err := decompress(r)
+	if err != nil {
+		return err
+	}
+
+	dec := xml.NewDecoder(r)
+	seenRoot := false
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch {
+		case !seenRoot:
+			if start.Name.Local != root {
+				return fmt.Errorf("unexpected root element <%s>, expected <%s>", start.Name.Local, root)
+			}
+			seenRoot = true
+		case start.Name.Local == child:
+			if err := decode(dec, start); err != nil {
+				return err
+			}
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !seenRoot {
+		return fmt.Errorf("missing <%s> element", root)
+	}
+	return nil
+}
+
+// decompress returns a reader decompressing r when it starts with the gzip magic number.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(2)
+	if len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		return br, nil
+	}
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, err
+	}
+	return zr, nil
+}
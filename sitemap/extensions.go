@@ -0,0 +1,236 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+)
+
+// Namespaces of the sitemap extensions supported by Google.
+const (
+	ImageNamespace = "http://www.google.com/schemas/sitemap-image/1.1"
+	VideoNamespace = "http://www.google.com/schemas/sitemap-video/1.1"
+	NewsNamespace  = "http://www.google.com/schemas/sitemap-news/0.9"
+)
+
+// MaxImages is the maximum number of images of a single URL.
+const MaxImages = 1000
+
+// MaxVideoDuration is the maximum duration of a video in seconds.
+const MaxVideoDuration = 28800
+
+// Image is an `<image:image>` entry of a URL.
+// For more details see: https://developers.google.com/search/docs/crawling-indexing/sitemaps/image-sitemaps
+type Image struct {
+	Loc     string `xml:"loc"`     // URL of the image
+	Caption string `xml:"caption"` // Caption of the image
+	License string `xml:"license"` // URL of the license of the image
+}
+
+// MarshalXML encodes the Image as `<image:image>` element.
+func (img Image) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	x := &extEncoder{e: e, prefix: "image"}
+	x.start("image")
+	x.text("loc", img.Loc)
+	x.text("caption", img.Caption)
+	x.text("license", img.License)
+	x.end("image")
+	return x.err
+}
+
+// Relationship tells whether the countries of a Restriction are allowed or denied.
+type Relationship string
+
+const (
+	RelationshipAllow Relationship = "allow" // The video is shown in the listed countries only
+	RelationshipDeny  Relationship = "deny"  // The video is shown everywhere but in the listed countries
+)
+
+// Restriction restricts the countries a video is shown in.
+type Restriction struct {
+	Relationship Relationship // Whether the countries are allowed or denied
+	Countries    []string     // ISO 3166 country codes, e.g. "IE", "GB"
+}
+
+// xmlRestriction is the XML representation of a Restriction.
+type xmlRestriction struct {
+	Relationship Relationship `xml:"relationship,attr"`
+	Countries    string       `xml:",chardata"`
+}
+
+// UnmarshalXML decodes a `<video:restriction>` element.
+func (r *Restriction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var x xmlRestriction
+	if err := d.DecodeElement(&x, &start); err != nil {
+		return err
+	}
+	*r = Restriction{Relationship: Relationship(strings.TrimSpace(string(x.Relationship))), Countries: strings.Fields(x.Countries)}
+	return nil
+}
+
+// Video is a `<video:video>` entry of a URL.
+// For more details see: https://developers.google.com/search/docs/crawling-indexing/sitemaps/video-sitemaps
+type Video struct {
+	ThumbnailLoc string       `xml:"thumbnail_loc"` // URL of the thumbnail
+	Title        string       `xml:"title"`         // Title of the video
+	Description  string       `xml:"description"`   // Description of the video, up to 2048 characters
+	ContentLoc   string       `xml:"content_loc"`   // URL of the video file
+	PlayerLoc    string       `xml:"player_loc"`    // URL of the video player
+	Duration     int          `xml:"duration"`      // Duration in seconds, from 1 to 28800; omitted when zero
+	Restriction  *Restriction `xml:"restriction"`   // Countries the video is shown in or not
+}
+
+// MarshalXML encodes the Video as `<video:video>` element.
+func (v Video) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	x := &extEncoder{e: e, prefix: "video"}
+	x.start("video")
+	x.text("thumbnail_loc", v.ThumbnailLoc)
+	x.text("title", v.Title)
+	x.text("description", v.Description)
+	x.text("content_loc", v.ContentLoc)
+	x.text("player_loc", v.PlayerLoc)
+	if v.Duration > 0 {
+		x.text("duration", strconv.Itoa(v.Duration))
+	}
+	if v.Restriction != nil && len(v.Restriction.Countries) > 0 {
+		x.element("restriction", xmlRestriction{
+			Relationship: v.Restriction.Relationship,
+			Countries:    strings.Join(v.Restriction.Countries, " "),
+		})
+	}
+	x.end("video")
+	return x.err
+}
+
+// Publication is the publication of a News article.
+type Publication struct {
+	Name     string `xml:"name"`     // Name of the publication, as it appears on the articles
+	Language string `xml:"language"` // ISO 639 language code of the publication, e.g. "en" or "zh-cn"
+}
+
+// News is a `<news:news>` entry of a URL.
+// For more details see: https://developers.google.com/search/docs/crawling-indexing/sitemaps/news-sitemap
+type News struct {
+	Publication     Publication // Publication of the article
+	PublicationDate time.Time   // Publication date of the article, rendered as W3C datetime
+	Title           string      // Title of the article
+}
+
+// xmlNews is the XML representation of a News, when decoded.
+type xmlNews struct {
+	Publication     Publication `xml:"publication"`
+	PublicationDate string      `xml:"publication_date"`
+	Title           string      `xml:"title"`
+}
+
+// MarshalXML encodes the News as `<news:news>` element.
+func (n News) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	x := &extEncoder{e: e, prefix: "news"}
+	x.start("news")
+	x.start("publication")
+	x.text("name", n.Publication.Name)
+	x.text("language", n.Publication.Language)
+	x.end("publication")
+	x.text("publication_date", formatTime(n.PublicationDate))
+	x.text("title", n.Title)
+	x.end("news")
+	return x.err
+}
+
+// UnmarshalXML decodes a `<news:news>` element.
+func (n *News) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var x xmlNews
+	if err := d.DecodeElement(&x, &start); err != nil {
+		return err
+	}
+	date, err := parseTime(x.PublicationDate)
+	if err != nil {
+		return fmt.Errorf("invalid news publication_date: %w", err)
+	}
+	*n = News{
+		Publication:     Publication{Name: strings.TrimSpace(x.Publication.Name), Language: strings.TrimSpace(x.Publication.Language)},
+		PublicationDate: date,
+		Title:           strings.TrimSpace(x.Title),
+	}
+	return nil
+}
+
+// validateExtensions checks the images, videos and news of the URL u.
+func validateExtensions(v *validate.Validator, u URL) {
+	if len(u.Images) > MaxImages {
+		v.Add(teseo.SeverityError, "images", "max", fmt.Sprintf("URL has %d images, the maximum is %d", len(u.Images), MaxImages))
+	}
+	for i, img := range u.Images {
+		iv := v.Index("images", i)
+		iv.Required("loc", img.Loc != "")
+		iv.URL("loc", img.Loc)
+		iv.URL("license", img.License)
+	}
+
+	for i, video := range u.Videos {
+		vv := v.Index("videos", i)
+		vv.Required("thumbnail_loc", video.ThumbnailLoc != "")
+		vv.URL("thumbnail_loc", video.ThumbnailLoc)
+		vv.Required("title", validate.NotEmpty(video.Title))
+		vv.Required("description", validate.NotEmpty(video.Description))
+		vv.Required("content_loc", video.ContentLoc != "" || video.PlayerLoc != "")
+		vv.URL("content_loc", video.ContentLoc)
+		vv.URL("player_loc", video.PlayerLoc)
+		if video.Duration < 0 || video.Duration > MaxVideoDuration {
+			vv.Add(teseo.SeverityError, "duration", "range", fmt.Sprintf("duration %d is not between 1 and %d seconds", video.Duration, MaxVideoDuration))
+		}
+		if r := video.Restriction; r != nil && r.Relationship != RelationshipAllow && r.Relationship != RelationshipDeny {
+			vv.Add(teseo.SeverityError, "restriction", "relationship", fmt.Sprintf("invalid restriction relationship %q", r.Relationship))
+		}
+	}
+
+	if n := u.News; n != nil {
+		nv := v.Child("news")
+		nv.Required("publication.name", validate.NotEmpty(n.Publication.Name))
+		nv.Required("publication.language", n.Publication.Language != "")
+		nv.Required("publication_date", !n.PublicationDate.IsZero())
+		nv.Required("title", validate.NotEmpty(n.Title))
+	}
+}
+
+// extEncoder writes the elements of an extension namespace with its prefix, e.g. `<image:loc>`.
+// The first error is kept and stops the following writes.
+type extEncoder struct {
+	e      *xml.Encoder
+	prefix string
+	err    error
+}
+
+func (x *extEncoder) name(local string) xml.Name {
+	return xml.Name{Local: x.prefix + ":" + local}
+}
+
+func (x *extEncoder) start(local string) {
+	if x.err == nil {
+		x.err = x.e.EncodeToken(xml.StartElement{Name: x.name(local)})
+	}
+}
+
+func (x *extEncoder) end(local string) {
+	if x.err == nil {
+		x.err = x.e.EncodeToken(xml.EndElement{Name: x.name(local)})
+	}
+}
+
+// text writes an element with the text value, nothing when value is empty.
+func (x *extEncoder) text(local, value string) {
+	if value != "" {
+		x.element(local, value)
+	}
+}
+
+func (x *extEncoder) element(local string, v any) {
+	if x.err == nil {
+		x.err = x.e.EncodeElement(v, xml.StartElement{Name: x.name(local)})
+	}
+}
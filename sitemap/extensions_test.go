@@ -0,0 +1,121 @@
+package sitemap
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleExtensions = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:image="http://www.google.com/schemas/sitemap-image/1.1" xmlns:video="http://www.google.com/schemas/sitemap-video/1.1" xmlns:news="http://www.google.com/schemas/sitemap-news/0.9">
+  <url>
+    <loc>https://www.example.com/news/grilling</loc>
+    <image:image>
+      <image:loc>https://www.example.com/images/steak.jpg</image:loc>
+      <image:caption>A steak &amp; fries</image:caption>
+    </image:image>
+    <video:video>
+      <video:thumbnail_loc>https://www.example.com/thumbs/123.jpg</video:thumbnail_loc>
+      <video:title>Grilling steaks for summer</video:title>
+      <video:description>Alkis shows you how to get perfectly done steaks every time</video:description>
+      <video:content_loc>https://www.example.com/video/123.mp4</video:content_loc>
+      <video:duration>600</video:duration>
+      <video:restriction relationship="allow">IE GB US CA</video:restriction>
+    </video:video>
+    <news:news>
+      <news:publication>
+        <news:name>The Example Times</news:name>
+        <news:language>en</news:language>
+      </news:publication>
+      <news:publication_date>2024-09-01T10:30:00Z</news:publication_date>
+      <news:title>Grilling steaks for summer</news:title>
+    </news:news>
+  </url>
+</urlset>`
+
+var sampleExtensionsURL = URL{
+	Loc: "https://www.example.com/news/grilling",
+	Images: []Image{
+		{Loc: "https://www.example.com/images/steak.jpg", Caption: "A steak & fries"},
+	},
+	Videos: []Video{{
+		ThumbnailLoc: "https://www.example.com/thumbs/123.jpg",
+		Title:        "Grilling steaks for summer",
+		Description:  "Alkis shows you how to get perfectly done steaks every time",
+		ContentLoc:   "https://www.example.com/video/123.mp4",
+		Duration:     600,
+		Restriction:  &Restriction{Relationship: RelationshipAllow, Countries: []string{"IE", "GB", "US", "CA"}},
+	}},
+	News: &News{
+		Publication:     Publication{Name: "The Example Times", Language: "en"},
+		PublicationDate: time.Date(2024, 9, 1, 10, 30, 0, 0, time.UTC),
+		Title:           "Grilling steaks for summer",
+	},
+}
+
+// TestExtensions tests that the extensions are written with their namespaces and parsed back
+func TestExtensions(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(sampleExtensionsURL).Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if buf.String() != sampleExtensions {
+		t.Errorf("expected\n%s\ngot\n%s", sampleExtensions, buf.String())
+	}
+
+	sm := &Sitemap{}
+	if err := sm.Read(strings.NewReader(sampleExtensions)); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if len(sm.URLs) != 1 || !reflect.DeepEqual(sm.URLs[0], sampleExtensionsURL) {
+		t.Errorf("expected %+v, got %+v", sampleExtensionsURL, sm.URLs)
+	}
+}
+
+// TestExtensionsOtherPrefix tests that the extensions are matched by namespace, not by prefix
+func TestExtensionsOtherPrefix(t *testing.T) {
+	data := `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:img="http://www.google.com/schemas/sitemap-image/1.1" xmlns:other="https://example.com/other">
+  <url>
+    <loc>https://www.example.com/</loc>
+    <img:image><img:loc>https://www.example.com/a.jpg</img:loc></img:image>
+    <other:image><other:loc>https://www.example.com/b.jpg</other:loc></other:image>
+  </url>
+</urlset>`
+
+	sm := &Sitemap{}
+	if err := sm.Read(strings.NewReader(data)); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	expected := []Image{{Loc: "https://www.example.com/a.jpg"}}
+	if !reflect.DeepEqual(sm.URLs[0].Images, expected) {
+		t.Errorf("expected %+v, got %+v", expected, sm.URLs[0].Images)
+	}
+}
+
+// TestValidateExtensions tests the required properties of the extensions
+func TestValidateExtensions(t *testing.T) {
+	sm := New(URL{
+		Loc:    "https://www.example.com/",
+		Images: []Image{{}},
+		Videos: []Video{{ThumbnailLoc: "https://www.example.com/t.jpg", Title: "Title", Description: "Description", Duration: 30000}},
+		News:   &News{Title: "Title"},
+	})
+
+	var rules []string
+	for _, issue := range sm.Validate() {
+		rules = append(rules, issue.Rule)
+	}
+	expected := []string{
+		"sitemap.Sitemap.urls.images.loc.required",
+		"sitemap.Sitemap.urls.videos.content_loc.required",
+		"sitemap.Sitemap.urls.videos.duration.range",
+		"sitemap.Sitemap.urls.news.publication.name.required",
+		"sitemap.Sitemap.urls.news.publication.language.required",
+		"sitemap.Sitemap.urls.news.publication_date.required",
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("expected %v, got %v", expected, rules)
+	}
+}
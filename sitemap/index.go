@@ -0,0 +1,145 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+)
+
+// MaxFileSize is the maximum size in bytes of a single uncompressed sitemap or sitemap index file.
+const MaxFileSize = 50 * 1024 * 1024
+
+// Entry represents a single `<sitemap>` entry of a sitemap index.
+type Entry struct {
+	Loc     string    // Absolute URL of the sitemap file
+	LastMod time.Time // Last modification of the sitemap file, rendered as W3C datetime; omitted when zero
+}
+
+// xmlEntry is the XML representation of an Entry.
+type xmlEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// MarshalXML encodes the Entry as `<sitemap>` element.
+func (e Entry) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return enc.EncodeElement(xmlEntry{Loc: e.Loc, LastMod: formatTime(e.LastMod)}, start)
+}
+
+// UnmarshalXML decodes a `<sitemap>` element.
+func (e *Entry) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var x xmlEntry
+	if err := dec.DecodeElement(&x, &start); err != nil {
+		return err
+	}
+	lastMod, err := parseTime(x.LastMod)
+	if err != nil {
+		return fmt.Errorf("invalid lastmod of %q: %w", x.Loc, err)
+	}
+	*e = Entry{Loc: strings.TrimSpace(x.Loc), LastMod: lastMod}
+	return nil
+}
+
+// Index represents a sitemap index `<sitemapindex>` file, listing other sitemap files.
+//
+// Example usage:
+//
+//	idx := &sitemap.Index{Sitemaps: []sitemap.Entry{
+//		{Loc: "https://www.example.com/sitemap-1.xml.gz", LastMod: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+//	}}
+//	err := idx.Write(w)
+//
+// Expected output:
+//
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+//	  <sitemap>
+//	    <loc>https://www.example.com/sitemap-1.xml.gz</loc>
+//	    <lastmod>2024-09-01T00:00:00Z</lastmod>
+//	  </sitemap>
+//	</sitemapindex>
+type Index struct {
+	Sitemaps []Entry
+}
+
+// Write encodes the Index as XML to w. It makes Index implement teseo.SitemapRenderer.
+func (x *Index) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write sitemap index: %w", err)
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	index := xml.StartElement{
+		Name: xml.Name{Local: "sitemapindex"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: Namespace}},
+	}
+	if err := enc.EncodeToken(index); err != nil {
+		return fmt.Errorf("failed to write sitemap index: %w", err)
+	}
+	for _, e := range x.Sitemaps {
+		if err := enc.EncodeElement(e, xml.StartElement{Name: xml.Name{Local: "sitemap"}}); err != nil {
+			return fmt.Errorf("failed to write sitemap index entry %q: %w", e.Loc, err)
+		}
+	}
+	if err := enc.EncodeToken(index.End()); err != nil {
+		return fmt.Errorf("failed to write sitemap index: %w", err)
+	}
+	if err := enc.Flush(); err != nil {
+		return fmt.Errorf("failed to write sitemap index: %w", err)
+	}
+	return nil
+}
+
+// Read decodes the XML sitemap index read from r, replacing the entries of the Index.
+// Gzip compressed files are decompressed. It makes Index implement teseo.SitemapRenderer.
+func (x *Index) Read(r io.Reader) error {
+	x.Sitemaps = nil
+	err := decodeElements(r, "sitemapindex", "sitemap", func(dec *xml.Decoder, start xml.StartElement) error {
+		var e Entry
+		if err := dec.DecodeElement(&e, &start); err != nil {
+			return err
+		}
+		x.Sitemaps = append(x.Sitemaps, e)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read sitemap index: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the entries of the Index and the protocol limits.
+func (x *Index) Validate() []teseo.Issue {
+	v := validate.New("sitemap.Index")
+	if len(x.Sitemaps) > MaxURLs {
+		v.Add(teseo.SeverityError, "sitemaps", "max", fmt.Sprintf("sitemap index has %d sitemaps, the maximum is %d", len(x.Sitemaps), MaxURLs))
+	}
+	for i, e := range x.Sitemaps {
+		ev := v.Index("sitemaps", i)
+		ev.Required("loc", e.Loc != "")
+		ev.URL("loc", e.Loc)
+	}
+	return v.Issues()
+}
+
+// LoadIndex reads the sitemap index file name from fsys, e.g. an `embed.FS` or `os.DirFS`.
+func LoadIndex(fsys fs.FS, name string) (*Index, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sitemap index: %w", err)
+	}
+	defer f.Close()
+
+	x := &Index{}
+	if err := x.Read(f); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return x, nil
+}
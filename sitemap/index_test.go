@@ -0,0 +1,58 @@
+package sitemap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/indaco/teseo"
+)
+
+var _ teseo.SitemapRenderer = (*Index)(nil)
+
+const sampleIndex = `<?xml version="1.0" encoding="UTF-8"?>
+<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <sitemap>
+    <loc>https://www.example.com/sitemap-1.xml.gz</loc>
+    <lastmod>2024-09-01T00:00:00Z</lastmod>
+  </sitemap>
+  <sitemap>
+    <loc>https://www.example.com/sitemap-2.xml.gz</loc>
+  </sitemap>
+</sitemapindex>`
+
+// TestIndex tests that an Index is written and read back
+func TestIndex(t *testing.T) {
+	idx := &Index{Sitemaps: []Entry{
+		{Loc: "https://www.example.com/sitemap-1.xml.gz", LastMod: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{Loc: "https://www.example.com/sitemap-2.xml.gz"},
+	}}
+
+	var buf bytes.Buffer
+	if err := idx.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if buf.String() != sampleIndex {
+		t.Errorf("expected\n%s\ngot\n%s", sampleIndex, buf.String())
+	}
+
+	// Read a compressed index
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write([]byte(sampleIndex))
+	zw.Close()
+
+	read := &Index{}
+	if err := read.Read(&gz); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if len(read.Sitemaps) != 2 || read.Sitemaps[1].Loc != "https://www.example.com/sitemap-2.xml.gz" || !read.Sitemaps[0].LastMod.Equal(idx.Sitemaps[0].LastMod) {
+		t.Errorf("unexpected entries %+v", read.Sitemaps)
+	}
+
+	if err := read.Read(strings.NewReader(sampleSitemap)); err == nil || !strings.Contains(err.Error(), "<urlset>") {
+		t.Errorf("expected an unexpected root element error, got %v", err)
+	}
+}
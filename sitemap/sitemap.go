@@ -0,0 +1,284 @@
+// Package sitemap writes and reads XML sitemaps following the sitemaps.org protocol.
+// For more details see: https://www.sitemaps.org/protocol.html
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
+	"github.com/indaco/teseo/links"
+)
+
+// Namespace is the XML namespace of the sitemaps.org protocol.
+const Namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// MaxURLs is the maximum number of URLs of a single sitemap file.
+const MaxURLs = 50000
+
+// ChangeFreq is how frequently a page is likely to change.
+type ChangeFreq string
+
+const (
+	Always  ChangeFreq = "always" // Changes each time it is accessed
+	Hourly  ChangeFreq = "hourly"
+	Daily   ChangeFreq = "daily"
+	Weekly  ChangeFreq = "weekly"
+	Monthly ChangeFreq = "monthly"
+	Yearly  ChangeFreq = "yearly"
+	Never   ChangeFreq = "never" // Archived page
+)
+
+// Priority is the priority of a page relative to the other pages of the site, from 0.0 to 1.0.
+// Crawlers assume 0.5 when the priority is omitted.
+type Priority float64
+
+// NewPriority returns a pointer to the priority p, to set URL.Priority, e.g. NewPriority(0.8).
+func NewPriority(p float64) *Priority {
+	priority := Priority(p)
+	return &priority
+}
+
+// MarshalText formats the priority with at least one decimal, e.g. "0.5" or "1.0".
+func (p Priority) MarshalText() ([]byte, error) {
+	s := strconv.FormatFloat(float64(p), 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText parses a decimal priority.
+func (p *Priority) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseFloat(strings.TrimSpace(string(text)), 64)
+	if err != nil {
+		return fmt.Errorf("invalid priority %q", text)
+	}
+	*p = Priority(v)
+	return nil
+}
+
+// URL represents a single `<url>` entry of a sitemap.
+type URL struct {
+	Loc        string            // Absolute URL of the page
+	LastMod    time.Time         // Last modification of the page, rendered as W3C datetime; omitted when zero
+	ChangeFreq ChangeFreq        // How frequently the page is likely to change; omitted when empty
+	Priority   *Priority         // Priority of the page relative to the other pages of the site, see NewPriority; omitted when nil
+	Alternates []links.Alternate // Language alternates of the page, including the page itself and links.XDefault
+	Images     []Image           // Images of the page, see the image sitemap extension
+	Videos     []Video           // Videos of the page, see the video sitemap extension
+	News       *News             // News article published by the page, see the news sitemap extension
+}
+
+// xmlURL is the XML representation of a URL.
+type xmlURL struct {
+	Loc        string      `xml:"loc"`
+	LastMod    string      `xml:"lastmod,omitempty"`
+	ChangeFreq ChangeFreq  `xml:"changefreq,omitempty"`
+	Priority   *Priority   `xml:"priority,omitempty"`
+	Alternates []xhtmlLink `xml:"http://www.w3.org/1999/xhtml link"`
+	Images     []Image     `xml:"http://www.google.com/schemas/sitemap-image/1.1 image"`
+	Videos     []Video     `xml:"http://www.google.com/schemas/sitemap-video/1.1 video"`
+	News       *News       `xml:"http://www.google.com/schemas/sitemap-news/0.9 news"`
+}
+
+// MarshalXML encodes the URL as `<url>` element.
+func (u URL) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	x := xmlURL{
+		Loc:        u.Loc,
+		LastMod:    formatTime(u.LastMod),
+		ChangeFreq: u.ChangeFreq,
+		Priority:   u.Priority,
+		Alternates: alternateLinks(u.Alternates),
+		Images:     u.Images,
+		Videos:     u.Videos,
+		News:       u.News,
+	}
+	return e.EncodeElement(x, start)
+}
+
+// UnmarshalXML decodes a `<url>` element.
+func (u *URL) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var x xmlURL
+	if err := d.DecodeElement(&x, &start); err != nil {
+		return err
+	}
+	lastMod, err := parseTime(x.LastMod)
+	if err != nil {
+		return fmt.Errorf("invalid lastmod of %q: %w", x.Loc, err)
+	}
+	*u = URL{
+		Loc:        strings.TrimSpace(x.Loc),
+		LastMod:    lastMod,
+		ChangeFreq: ChangeFreq(strings.TrimSpace(string(x.ChangeFreq))),
+		Priority:   x.Priority,
+		Alternates: alternates(x.Alternates),
+		Images:     x.Images,
+		Videos:     x.Videos,
+		News:       x.News,
+	}
+	return nil
+}
+
+// Sitemap represents a sitemap `<urlset>` file.
+//
+// Example usage:
+//
+//	sm := sitemap.New(
+//		sitemap.URL{Loc: "https://www.example.com/", ChangeFreq: sitemap.Daily, Priority: sitemap.NewPriority(1)},
+//		sitemap.URL{Loc: "https://www.example.com/about", LastMod: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+//	)
+//
+//	// Writing the sitemap, e.g. to an http.ResponseWriter or a file
+//	err := sm.Write(w)
+//
+//	// Reading a sitemap, e.g. from an embedded file system
+//	sm, err := sitemap.Load(statics, "sitemap.xml")
+//
+// Expected output:
+//
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+//	  <url>
+//	    <loc>https://www.example.com/</loc>
+//	    <changefreq>daily</changefreq>
+//	    <priority>1.0</priority>
+//	  </url>
+//	  <url>
+//	    <loc>https://www.example.com/about</loc>
+//	    <lastmod>2024-09-01T00:00:00Z</lastmod>
+//	  </url>
+//	</urlset>
+type Sitemap struct {
+	URLs []URL
+}
+
+// New initializes a Sitemap with the provided URLs.
+func New(urls ...URL) *Sitemap {
+	return &Sitemap{URLs: urls}
+}
+
+// Add appends URLs to the Sitemap.
+func (s *Sitemap) Add(urls ...URL) {
+	s.URLs = append(s.URLs, urls...)
+}
+
+// Write encodes the Sitemap as XML to w, one `<url>` element at a time.
+// It makes Sitemap implement teseo.SitemapRenderer.
+func (s *Sitemap) Write(w io.Writer) error {
+	var ext extensions
+	for _, u := range s.URLs {
+		ext |= u.extensions()
+	}
+
+	enc, err := newURLSetEncoder(w, ext)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	for _, u := range s.URLs {
+		element, err := encodeURL(&buf, u)
+		if err != nil {
+			return err
+		}
+		if err := enc.add(element); err != nil {
+			return err
+		}
+	}
+	return enc.close()
+}
+
+// Read decodes the XML sitemap read from r, one `<url>` element at a time, replacing the URLs of the Sitemap.
+// Gzip compressed sitemaps are decompressed. It makes Sitemap implement teseo.SitemapRenderer.
+func (s *Sitemap) Read(r io.Reader) error {
+	s.URLs = nil
+	err := decodeElements(r, "urlset", "url", func(dec *xml.Decoder, start xml.StartElement) error {
+		var u URL
+		if err := dec.DecodeElement(&u, &start); err != nil {
+			return err
+		}
+		s.URLs = append(s.URLs, u)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read sitemap: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the URLs of the Sitemap and the protocol limits.
+func (s *Sitemap) Validate() []teseo.Issue {
+	v := validate.New("sitemap.Sitemap")
+	if len(s.URLs) > MaxURLs {
+		v.Add(teseo.SeverityError, "urls", "max", fmt.Sprintf("sitemap has %d URLs, the maximum is %d", len(s.URLs), MaxURLs))
+	}
+	for i, u := range s.URLs {
+		uv := v.Index("urls", i)
+		uv.Required("loc", u.Loc != "")
+		uv.URL("loc", u.Loc)
+		switch u.ChangeFreq {
+		case "", Always, Hourly, Daily, Weekly, Monthly, Yearly, Never:
+		default:
+			uv.Add(teseo.SeverityError, "changefreq", "value", fmt.Sprintf("invalid changefreq %q", u.ChangeFreq))
+		}
+		if p := u.Priority; p != nil && (*p < 0 || *p > 1) {
+			uv.Add(teseo.SeverityError, "priority", "range", fmt.Sprintf("priority %v is not between 0.0 and 1.0", float64(*p)))
+		}
+		validateExtensions(uv, u)
+	}
+	validateAlternates(v, s.URLs)
+	return v.Issues()
+}
+
+// Load reads the sitemap file name from fsys, e.g. an `embed.FS` or `os.DirFS`.
+func Load(fsys fs.FS, name string) (*Sitemap, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sitemap: %w", err)
+	}
+	defer f.Close()
+
+	s := &Sitemap{}
+	if err := s.Read(f); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return s, nil
+}
+
+// timeLayouts lists the W3C datetime layouts accepted for lastmod, see: https://www.w3.org/TR/NOTE-datetime
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+// formatTime formats t as W3C datetime, empty when t is zero.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parseTime parses a W3C datetime, the zero time when s is empty.
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("not a W3C datetime: %q", s)
+}
@@ -0,0 +1,137 @@
+package sitemap
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/indaco/teseo"
+)
+
+var _ teseo.SitemapRenderer = (*Sitemap)(nil)
+
+const sampleSitemap = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url>
+    <loc>https://www.example.com/</loc>
+    <changefreq>daily</changefreq>
+    <priority>1.0</priority>
+  </url>
+  <url>
+    <loc>https://www.example.com/about?lang=en&amp;ref=nav</loc>
+    <lastmod>2024-09-01T10:30:00+02:00</lastmod>
+    <priority>0.25</priority>
+  </url>
+</urlset>`
+
+var sampleURLs = []URL{
+	{Loc: "https://www.example.com/", ChangeFreq: Daily, Priority: NewPriority(1)},
+	{Loc: "https://www.example.com/about?lang=en&ref=nav", LastMod: time.Date(2024, 9, 1, 10, 30, 0, 0, time.FixedZone("", 2*60*60)), Priority: NewPriority(0.25)},
+}
+
+// TestWrite tests the XML encoding of a Sitemap
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New(sampleURLs...).Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if buf.String() != sampleSitemap {
+		t.Errorf("expected\n%s\ngot\n%s", sampleSitemap, buf.String())
+	}
+}
+
+// TestLoad tests reading a sitemap from a file system
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"statics/sitemap.xml": {Data: []byte(sampleSitemap)},
+		"statics/feed.xml":    {Data: []byte(`<rss version="2.0"></rss>`)},
+	}
+
+	sm, err := Load(fsys, "statics/sitemap.xml")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(sm.URLs) != len(sampleURLs) {
+		t.Fatalf("expected %d URLs, got %d", len(sampleURLs), len(sm.URLs))
+	}
+	for i, u := range sm.URLs {
+		expected := sampleURLs[i]
+		if u.Loc != expected.Loc || !u.LastMod.Equal(expected.LastMod) || u.ChangeFreq != expected.ChangeFreq || *u.Priority != *expected.Priority {
+			t.Errorf("expected %+v, got %+v", expected, u)
+		}
+	}
+
+	if _, err := Load(fsys, "statics/feed.xml"); err == nil || !strings.Contains(err.Error(), "<rss>") {
+		t.Errorf("expected an unexpected root element error, got %v", err)
+	}
+	if _, err := Load(fsys, "statics/missing.xml"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+// TestZeroPriority tests that an explicit 0.0 priority is written and read back, unlike an unset one
+func TestZeroPriority(t *testing.T) {
+	var buf bytes.Buffer
+	sm := New(URL{Loc: "https://www.example.com/archive", Priority: NewPriority(0)}, URL{Loc: "https://www.example.com/"})
+	if err := sm.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if n := strings.Count(buf.String(), "<priority>0.0</priority>"); n != 1 || strings.Count(buf.String(), "<priority>") != 1 {
+		t.Fatalf("expected a single 0.0 priority, got\n%s", buf.String())
+	}
+
+	read := &Sitemap{}
+	if err := read.Read(&buf); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if p := read.URLs[0].Priority; p == nil || *p != 0 {
+		t.Errorf("expected an explicit 0.0 priority, got %v", p)
+	}
+	if p := read.URLs[1].Priority; p != nil {
+		t.Errorf("expected no priority, got %v", *p)
+	}
+}
+
+// TestParseTime tests the W3C datetime layouts of lastmod
+func TestParseTime(t *testing.T) {
+	tests := map[string]time.Time{
+		"2024":                      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"2024-09":                   time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+		"2024-09-01":                time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+		"2024-09-01T10:30Z":         time.Date(2024, 9, 1, 10, 30, 0, 0, time.UTC),
+		"2024-09-01T10:30:15.5Z":    time.Date(2024, 9, 1, 10, 30, 15, 500000000, time.UTC),
+		"2024-09-01T10:30:15-05:00": time.Date(2024, 9, 1, 15, 30, 15, 0, time.UTC),
+	}
+	for value, expected := range tests {
+		got, err := parseTime(value)
+		if err != nil || !got.Equal(expected) {
+			t.Errorf("parseTime(%q): expected %v, got %v (%v)", value, expected, got, err)
+		}
+	}
+
+	if _, err := parseTime("01/09/2024"); err == nil {
+		t.Error("expected an error for a non W3C datetime")
+	}
+}
+
+// TestValidate tests the loc, changefreq and priority rules
+func TestValidate(t *testing.T) {
+	sm := New(URL{Loc: "https://www.example.com/", ChangeFreq: "sometimes"}, URL{Priority: NewPriority(2)}, URL{Loc: "/about"})
+
+	var rules []string
+	for _, issue := range sm.Validate() {
+		rules = append(rules, issue.Rule+" "+issue.Field)
+	}
+	expected := []string{
+		"sitemap.Sitemap.urls.changefreq.value urls[0].changefreq",
+		"sitemap.Sitemap.urls.loc.required urls[1].loc",
+		"sitemap.Sitemap.urls.priority.range urls[1].priority",
+		"sitemap.Sitemap.urls.loc.url urls[2].loc",
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("expected %v, got %v", expected, rules)
+	}
+}
@@ -0,0 +1,268 @@
+package sitemap
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Splitter writes URLs to as many sitemap files as needed to respect the protocol limits,
+// and returns the Index listing them.
+//
+// Example usage:
+//
+//	splitter := &sitemap.Splitter{
+//		BaseURL: "https://www.example.com/sitemaps/",
+//		Dir:     "public/sitemaps",
+//		Gzip:    true,
+//	}
+//	idx, err := splitter.Write(urls) // public/sitemaps/sitemap-1.xml.gz, public/sitemaps/sitemap-2.xml.gz, ...
+//
+//	// Write the index, e.g. to public/sitemap.xml
+//	err = idx.Write(f)
+type Splitter struct {
+	BaseURL  string // Public URL of the directory serving the files, e.g. "https://www.example.com/sitemaps/"
+	Dir      string // Directory the files are written to when Create is nil
+	Pattern  string // File name pattern, formatted with the file number from 1; "sitemap-%d.xml" when empty
+	Gzip     bool   // Gzip compress the files and append ".gz" to their names
+	MaxURLs  int    // Maximum number of URLs per file; MaxURLs when zero
+	MaxBytes int64  // Maximum uncompressed size of a file in bytes; MaxFileSize when zero
+
+	// Create opens the file name for writing; the files are created in Dir when nil.
+	Create func(name string) (io.WriteCloser, error)
+	// Remove deletes the file name after a failure; the files are removed from Dir when nil and Create is nil,
+	// and left in place when nil and Create is set.
+	Remove func(name string) error
+	// Progress is called after each URL with the number of files, URLs and uncompressed bytes written so far.
+	Progress func(Stats)
+}
+
+// Write writes urls to the sitemap files and returns the Index listing them. The lastmod of each
+// file is the latest lastmod of its URLs, or the time of the call when none of them has one.
+// On failure no Index is returned and the files written so far are deleted, see Remove.
+func (s *Splitter) Write(urls []URL) (*Index, error) {
+	var ext extensions
+	for _, u := range urls {
+		ext |= u.extensions()
+	}
+	return s.write(Values(urls), nil, ext)
+}
+
+// WriteSeq writes the URLs of seq to the sitemap files as they are yielded, holding a single URL
+// in memory, and returns the Index listing the files. As the URLs are not known in advance, the
+// namespaces of every extension are declared. Progress, when set, is called after each URL.
+// seqErr, when not nil, is called once the iteration ends: when it returns an error, e.g. a failed
+// query, no Index is returned and the error is. The files written so far are deleted, as on any
+// failure, see Remove.
+//
+// Example usage:
+//
+//	var scanErr error
+//	idx, err := splitter.WriteSeq(func(yield func(sitemap.URL) bool) {
+//		for rows.Next() {
+//			var u sitemap.URL
+//			if scanErr = rows.Scan(&u.Loc, &u.LastMod); scanErr != nil || !yield(u) {
+//				return
+//			}
+//		}
+//	}, func() error { return errors.Join(scanErr, rows.Err()) })
+func (s *Splitter) WriteSeq(seq Seq, seqErr func() error) (*Index, error) {
+	return s.write(seq, seqErr, allExtensions)
+}
+
+// write writes the URLs of seq to the sitemap files declaring the extensions ext.
+func (s *Splitter) write(seq Seq, seqErr func() error, ext extensions) (*Index, error) {
+	idx := &Index{}
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var shard *shardWriter
+	var names []string
+	var buf bytes.Buffer
+	var stats Stats
+
+	err := each(seq, seqErr, func(u URL) error {
+		element, err := encodeURL(&buf, u)
+		if err != nil {
+			return err
+		}
+		size := int64(len(element) + len(closeURLSet))
+
+		if shard != nil && (shard.enc.count >= s.maxURLs() || shard.enc.size+size > s.maxBytes()) {
+			stats.Bytes += shard.enc.size + int64(len(closeURLSet))
+			err := s.closeShard(idx, shard, now)
+			shard = nil
+			if err != nil {
+				return err
+			}
+		}
+		if shard == nil {
+			if shard, err = s.open(len(idx.Sitemaps)+1, ext); err != nil {
+				return err
+			}
+			names = append(names, shard.name)
+			stats.Files++
+			if shard.enc.size+size > s.maxBytes() {
+				return fmt.Errorf("sitemap URL %q does not fit in a file of %d bytes", u.Loc, s.maxBytes())
+			}
+		}
+
+		if err := shard.enc.add(element); err != nil {
+			return err
+		}
+		if u.LastMod.After(shard.lastMod) {
+			shard.lastMod = u.LastMod
+		}
+
+		stats.URLs++
+		if s.Progress != nil {
+			s.Progress(Stats{Files: stats.Files, URLs: stats.URLs, Bytes: stats.Bytes + shard.enc.size})
+		}
+		return nil
+	})
+
+	if err != nil {
+		if shard != nil {
+			shard.abort()
+		}
+		return nil, errors.Join(err, s.remove(names))
+	}
+	if shard != nil {
+		if err := s.closeShard(idx, shard, now); err != nil {
+			return nil, errors.Join(err, s.remove(names))
+		}
+	}
+	return idx, nil
+}
+
+// shardWriter is a sitemap file being written.
+type shardWriter struct {
+	name    string
+	file    io.WriteCloser
+	bw      *bufio.Writer
+	gz      *gzip.Writer
+	enc     *urlsetEncoder
+	lastMod time.Time
+}
+
+// close ends the document and closes the file.
+func (w *shardWriter) close() error {
+	err := w.enc.close()
+	if w.gz != nil {
+		if gzErr := w.gz.Close(); err == nil && gzErr != nil {
+			err = fmt.Errorf("failed to compress sitemap: %w", gzErr)
+		}
+	}
+	if flushErr := w.bw.Flush(); err == nil && flushErr != nil {
+		err = fmt.Errorf("failed to write sitemap: %w", flushErr)
+	}
+	if closeErr := w.file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close sitemap: %w", closeErr)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", w.name, err)
+	}
+	return nil
+}
+
+// abort closes the file after a failure.
+func (w *shardWriter) abort() {
+	w.file.Close()
+}
+
+// open creates the n-th sitemap file and writes the beginning of the document declaring the extensions ext.
+func (s *Splitter) open(n int, ext extensions) (*shardWriter, error) {
+	pattern := s.Pattern
+	if pattern == "" {
+		pattern = "sitemap-%d.xml"
+	}
+	name := fmt.Sprintf(pattern, n)
+	if s.Gzip {
+		name += ".gz"
+	}
+
+	create := s.Create
+	if create == nil {
+		create = func(name string) (io.WriteCloser, error) {
+			return os.Create(filepath.Join(s.Dir, name))
+		}
+	}
+	file, err := create(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sitemap %s: %w", name, err)
+	}
+
+	shard := &shardWriter{name: name, file: file, bw: bufio.NewWriter(file)}
+	var w io.Writer = shard.bw
+	if s.Gzip {
+		shard.gz = gzip.NewWriter(shard.bw)
+		w = shard.gz
+	}
+	if shard.enc, err = newURLSetEncoder(w, ext); err != nil {
+		file.Close()
+		return nil, errors.Join(fmt.Errorf("%s: %w", name, err), s.remove([]string{name}))
+	}
+	return shard, nil
+}
+
+// remove deletes the files names written before a failure, see Remove.
+func (s *Splitter) remove(names []string) error {
+	remove := s.Remove
+	if remove == nil {
+		if s.Create != nil {
+			return nil
+		}
+		remove = func(name string) error {
+			return os.Remove(filepath.Join(s.Dir, name))
+		}
+	}
+
+	var errs []error
+	for _, name := range names {
+		if err := remove(name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove sitemap %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// closeShard closes the sitemap file and adds it to the Index.
+func (s *Splitter) closeShard(idx *Index, shard *shardWriter, now time.Time) error {
+	if err := shard.close(); err != nil {
+		return err
+	}
+	lastMod := shard.lastMod
+	if lastMod.IsZero() {
+		lastMod = now
+	}
+	idx.Sitemaps = append(idx.Sitemaps, Entry{Loc: s.loc(shard.name), LastMod: lastMod})
+	return nil
+}
+
+// loc returns the public URL of the file name.
+func (s *Splitter) loc(name string) string {
+	if s.BaseURL == "" {
+		return name
+	}
+	return strings.TrimSuffix(s.BaseURL, "/") + "/" + name
+}
+
+func (s *Splitter) maxURLs() int {
+	if s.MaxURLs > 0 && s.MaxURLs < MaxURLs {
+		return s.MaxURLs
+	}
+	return MaxURLs
+}
+
+func (s *Splitter) maxBytes() int64 {
+	if s.MaxBytes > 0 && s.MaxBytes < MaxFileSize {
+		return s.MaxBytes
+	}
+	return MaxFileSize
+}
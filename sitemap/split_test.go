@@ -0,0 +1,105 @@
+package sitemap
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// memFiles collects the files written by a Splitter.
+type memFiles fstest.MapFS
+
+type memFile struct {
+	bytes.Buffer
+	files memFiles
+	name  string
+}
+
+func (f *memFile) Close() error {
+	f.files[f.name] = &fstest.MapFile{Data: f.Bytes()}
+	return nil
+}
+
+func (m memFiles) create(name string) (io.WriteCloser, error) {
+	return &memFile{files: m, name: name}, nil
+}
+
+// TestSplitter tests the sharding by URL count and by size, the compression and the index lastmod
+func TestSplitter(t *testing.T) {
+	var urls []URL
+	for i := 1; i <= 5; i++ {
+		urls = append(urls, URL{
+			Loc:     fmt.Sprintf("https://www.example.com/products/%d", i),
+			LastMod: time.Date(2024, 9, i, 0, 0, 0, 0, time.UTC),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		splitter Splitter
+		expected []int // number of URLs per file
+	}{
+		{name: "single file", splitter: Splitter{}, expected: []int{5}},
+		{name: "max urls", splitter: Splitter{MaxURLs: 2}, expected: []int{2, 2, 1}},
+		{name: "max bytes", splitter: Splitter{MaxBytes: 450}, expected: []int{3, 2}},
+		{name: "gzip", splitter: Splitter{MaxURLs: 4, Gzip: true}, expected: []int{4, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := memFiles{}
+			tt.splitter.BaseURL = "https://www.example.com/sitemaps/"
+			tt.splitter.Create = files.create
+
+			idx, err := tt.splitter.Write(urls)
+			if err != nil {
+				t.Fatalf("Write failed: %v", err)
+			}
+			if len(idx.Sitemaps) != len(tt.expected) {
+				t.Fatalf("expected %d files, got %+v", len(tt.expected), idx.Sitemaps)
+			}
+
+			position := 0
+			for i, entry := range idx.Sitemaps {
+				name := fmt.Sprintf("sitemap-%d.xml", i+1)
+				if tt.splitter.Gzip {
+					name += ".gz"
+				}
+				if entry.Loc != "https://www.example.com/sitemaps/"+name {
+					t.Errorf("unexpected loc %q", entry.Loc)
+				}
+
+				sm, err := Load(fstest.MapFS(files), name)
+				if err != nil {
+					t.Fatalf("Load failed: %v", err)
+				}
+				if len(sm.URLs) != tt.expected[i] {
+					t.Errorf("%s: expected %d URLs, got %d", name, tt.expected[i], len(sm.URLs))
+				}
+				if tt.splitter.Gzip && !bytes.HasPrefix(files[name].Data, []byte{0x1f, 0x8b}) {
+					t.Errorf("%s: expected a gzip compressed file", name)
+				}
+				if tt.splitter.MaxBytes > 0 && !tt.splitter.Gzip && int64(len(files[name].Data)) > tt.splitter.MaxBytes {
+					t.Errorf("%s: %d bytes exceed the limit", name, len(files[name].Data))
+				}
+
+				position += len(sm.URLs)
+				if expected := urls[position-1].LastMod; !entry.LastMod.Equal(expected) {
+					t.Errorf("%s: expected lastmod %v, got %v", name, expected, entry.LastMod)
+				}
+			}
+		})
+	}
+}
+
+// TestSplitterTooLarge tests that a URL larger than the file size limit is reported
+func TestSplitterTooLarge(t *testing.T) {
+	files := memFiles{}
+	splitter := &Splitter{MaxBytes: 100, Create: files.create}
+	if _, err := splitter.Write([]URL{{Loc: "https://www.example.com/"}}); err == nil {
+		t.Error("expected an error")
+	}
+}
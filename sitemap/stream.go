@@ -0,0 +1,170 @@
+package sitemap
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// ErrClosed is returned by the Encoder methods called after Close.
+var ErrClosed = errors.New("sitemap: encoder closed")
+
+// Seq is a sequence of URLs, e.g. the rows of a database query, yielded one at a time.
+// It has the shape of iter.Seq[URL]: iteration stops when yield returns false.
+//
+// The writers accepting a Seq take a function returning the error of its source, e.g. a failed
+// query, called once the iteration ends, so a truncated sitemap is never reported as complete.
+//
+// Example usage:
+//
+//	var scanErr error
+//	urls := func(yield func(sitemap.URL) bool) {
+//		for rows.Next() {
+//			var u sitemap.URL
+//			if scanErr = rows.Scan(&u.Loc, &u.LastMod); scanErr != nil || !yield(u) {
+//				return
+//			}
+//		}
+//	}
+//	seqErr := func() error { return errors.Join(scanErr, rows.Err()) }
+type Seq func(yield func(URL) bool)
+
+// Values returns a Seq yielding urls.
+func Values(urls []URL) Seq {
+	return func(yield func(URL) bool) {
+		for _, u := range urls {
+			if !yield(u) {
+				return
+			}
+		}
+	}
+}
+
+// each calls fn with the URLs of seq and returns the first error of fn or, once the iteration
+// ends, the error of seqErr when not nil. The iteration stops at the first error of fn;
+// URLs yielded afterwards anyway are ignored.
+func each(seq Seq, seqErr func() error, fn func(URL) error) error {
+	var err error
+	seq(func(u URL) bool {
+		if err != nil {
+			return false
+		}
+		err = fn(u)
+		return err == nil
+	})
+	if err != nil || seqErr == nil {
+		return err
+	}
+	return seqErr()
+}
+
+// Stats reports the progress of a streaming write.
+type Stats struct {
+	Files int   // Number of files written, 1 for a single document
+	URLs  int   // Number of URLs written
+	Bytes int64 // Number of uncompressed bytes written
+}
+
+// allExtensions declares every extension namespace, for URLs which are not known in advance.
+const allExtensions = extImage | extVideo | extNews | extXHTML
+
+// Encoder writes a sitemap `<urlset>` document one URL at a time, holding a single URL in memory.
+// As the URLs are not known in advance, the namespaces of every extension are declared.
+//
+// Example usage:
+//
+//	enc := sitemap.NewEncoder(w)
+//	for rows.Next() {
+//		var u sitemap.URL
+//		if err := rows.Scan(&u.Loc); err != nil {
+//			return err
+//		}
+//		if err := enc.Encode(u); err != nil {
+//			return err
+//		}
+//	}
+//	if err := rows.Err(); err != nil {
+//		return err
+//	}
+//	err := enc.Close()
+type Encoder struct {
+	w      io.Writer
+	enc    *urlsetEncoder
+	buf    bytes.Buffer
+	err    error
+	closed bool
+}
+
+// NewEncoder returns an Encoder writing to w. The XML declaration is written with the first URL.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes u to the document. After an error every call returns the same error,
+// after Close it returns ErrClosed.
+func (e *Encoder) Encode(u URL) error {
+	if e.closed {
+		return ErrClosed
+	}
+	if e.start() != nil {
+		return e.err
+	}
+	element, err := encodeURL(&e.buf, u)
+	if err != nil {
+		e.err = err
+		return err
+	}
+	e.err = e.enc.add(element)
+	return e.err
+}
+
+// Close ends the document. It does not close the underlying writer.
+// Calling Close more than once returns ErrClosed.
+func (e *Encoder) Close() error {
+	if e.closed {
+		return ErrClosed
+	}
+	e.closed = true
+	if e.start() != nil {
+		return e.err
+	}
+	e.err = e.enc.close()
+	return e.err
+}
+
+// Stats returns the number of URLs and bytes written so far.
+func (e *Encoder) Stats() Stats {
+	if e.enc == nil {
+		return Stats{}
+	}
+	return Stats{Files: 1, URLs: e.enc.count, Bytes: e.enc.size}
+}
+
+// start writes the beginning of the document once.
+func (e *Encoder) start() error {
+	if e.enc == nil && e.err == nil {
+		e.enc, e.err = newURLSetEncoder(e.w, allExtensions)
+	}
+	return e.err
+}
+
+// WriteSeq writes the URLs of seq as a single sitemap document to w, calling progress, when not nil,
+// after each URL. It returns the final Stats; the protocol limits are not enforced, see Splitter.WriteSeq.
+// When seqErr, if not nil, returns an error the document is left unterminated and the error is returned.
+func WriteSeq(w io.Writer, seq Seq, seqErr func() error, progress func(Stats)) (Stats, error) {
+	enc := NewEncoder(w)
+	err := each(seq, seqErr, func(u URL) error {
+		if err := enc.Encode(u); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(enc.Stats())
+		}
+		return nil
+	})
+	if err != nil {
+		return enc.Stats(), err
+	}
+	err = enc.Close()
+	return enc.Stats(), err
+}
@@ -0,0 +1,235 @@
+package sitemap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// products yields n product URLs without holding them in memory.
+func products(n int) Seq {
+	return func(yield func(URL) bool) {
+		for i := 1; i <= n; i++ {
+			if !yield(URL{Loc: fmt.Sprintf("https://www.example.com/products/%d", i)}) {
+				return
+			}
+		}
+	}
+}
+
+// TestWriteSeq tests that the streamed document matches the in memory one, and the reported stats
+func TestWriteSeq(t *testing.T) {
+	var buf bytes.Buffer
+	calls := 0
+	stats, err := WriteSeq(&buf, Values(sampleURLs), nil, func(s Stats) {
+		calls++
+		if s.URLs != calls {
+			t.Errorf("expected %d URLs, got %d", calls, s.URLs)
+		}
+	})
+	if err != nil {
+		t.Fatalf("WriteSeq failed: %v", err)
+	}
+
+	if calls != 2 || stats.URLs != 2 || stats.Files != 1 || stats.Bytes != int64(buf.Len()) {
+		t.Errorf("unexpected stats %+v after %d calls for %d bytes", stats, calls, buf.Len())
+	}
+
+	sm := &Sitemap{}
+	if err := sm.Read(&buf); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if len(sm.URLs) != 2 || sm.URLs[1].Loc != sampleURLs[1].Loc {
+		t.Errorf("unexpected URLs %+v", sm.URLs)
+	}
+}
+
+// TestEncoderEmpty tests that an empty document is still valid
+func TestEncoderEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if !strings.HasSuffix(buf.String(), "\n</urlset>") {
+		t.Errorf("unexpected document %s", buf.String())
+	}
+	if err := (&Sitemap{}).Read(&buf); err != nil {
+		t.Errorf("Read failed: %v", err)
+	}
+}
+
+// TestEncoderClosed tests that the Encoder does not write after Close
+func TestEncoderClosed(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	if err := enc.Encode(sampleURLs[0]); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	size := buf.Len()
+
+	if err := enc.Encode(sampleURLs[1]); !errors.Is(err, ErrClosed) {
+		t.Errorf("expected ErrClosed from Encode, got %v", err)
+	}
+	if err := enc.Close(); !errors.Is(err, ErrClosed) {
+		t.Errorf("expected ErrClosed from Close, got %v", err)
+	}
+	if buf.Len() != size {
+		t.Errorf("expected no write after Close, got %s", buf.String())
+	}
+}
+
+// failingWriter fails after n bytes.
+type failingWriter struct{ n int }
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	if len(b) > w.n {
+		return w.n, errors.New("disk full")
+	}
+	w.n -= len(b)
+	return len(b), nil
+}
+
+// TestWriteSeqError tests that the iteration stops at the first error
+func TestWriteSeqError(t *testing.T) {
+	yielded := 0
+	seq := func(yield func(URL) bool) {
+		for _, u := range sampleURLs {
+			yielded++
+			if !yield(u) {
+				return
+			}
+		}
+	}
+
+	if _, err := WriteSeq(&failingWriter{n: 300}, seq, nil, nil); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("expected a write error, got %v", err)
+	}
+	if yielded != 1 {
+		t.Errorf("expected the iteration to stop after 1 URL, got %d", yielded)
+	}
+}
+
+// TestSeqError tests that the error of the sequence is returned instead of a truncated sitemap
+func TestSeqError(t *testing.T) {
+	errQuery := errors.New("connection reset")
+	seq := products(2)
+	seqErr := func() error { return errQuery }
+
+	var buf bytes.Buffer
+	if stats, err := WriteSeq(&buf, seq, seqErr, nil); !errors.Is(err, errQuery) || stats.URLs != 2 {
+		t.Errorf("expected the sequence error after 2 URLs, got %+v, %v", stats, err)
+	}
+	if strings.Contains(buf.String(), "</urlset>") {
+		t.Errorf("expected an unterminated document, got %s", buf.String())
+	}
+
+	files := memFiles{}
+	if idx, err := (&Splitter{Create: files.create}).WriteSeq(seq, seqErr); !errors.Is(err, errQuery) || idx != nil {
+		t.Errorf("expected the sequence error and no index, got %+v, %v", idx, err)
+	}
+}
+
+// TestSplitterRemove tests that the files written before a failure are deleted
+func TestSplitterRemove(t *testing.T) {
+	errQuery := errors.New("connection reset")
+	seqErr := func() error { return errQuery }
+
+	dir := t.TempDir()
+	splitter := &Splitter{Dir: dir, MaxURLs: 1}
+	if _, err := splitter.WriteSeq(products(3), seqErr); !errors.Is(err, errQuery) {
+		t.Fatalf("expected the sequence error, got %v", err)
+	}
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 0 {
+		t.Errorf("expected no file left, got %v, %v", entries, err)
+	}
+
+	files := memFiles{}
+	var removed []string
+	splitter = &Splitter{
+		MaxURLs: 1,
+		Create:  files.create,
+		Remove: func(name string) error {
+			removed = append(removed, name)
+			return nil
+		},
+	}
+	if _, err := splitter.WriteSeq(products(3), seqErr); !errors.Is(err, errQuery) {
+		t.Fatalf("expected the sequence error, got %v", err)
+	}
+	if len(removed) != 3 || removed[2] != "sitemap-3.xml" {
+		t.Errorf("expected the 3 files removed, got %v", removed)
+	}
+}
+
+// TestSplitterWriteSeq tests the sharding of a sequence and the reported stats
+func TestSplitterWriteSeq(t *testing.T) {
+	files := memFiles{}
+	var last Stats
+	splitter := &Splitter{
+		MaxURLs: 1000,
+		Create:  files.create,
+		Progress: func(s Stats) {
+			if s.URLs != last.URLs+1 || s.Bytes <= last.Bytes {
+				t.Fatalf("unexpected progress %+v after %+v", s, last)
+			}
+			last = s
+		},
+	}
+
+	idx, err := splitter.WriteSeq(products(2500), nil)
+	if err != nil {
+		t.Fatalf("WriteSeq failed: %v", err)
+	}
+	if len(idx.Sitemaps) != 3 || last.Files != 3 || last.URLs != 2500 {
+		t.Fatalf("unexpected index %+v and stats %+v", idx.Sitemaps, last)
+	}
+
+	var size int64
+	for _, entry := range idx.Sitemaps {
+		size += int64(len(files[entry.Loc].Data))
+	}
+	// The end tag of the last file is written after the last progress call
+	if size != last.Bytes+int64(len(closeURLSet)) {
+		t.Errorf("expected %d bytes, got %d", size, last.Bytes+int64(len(closeURLSet)))
+	}
+}
+
+// TestSplitterCreateError tests that a file creation error is returned
+func TestSplitterCreateError(t *testing.T) {
+	splitter := &Splitter{Create: func(name string) (io.WriteCloser, error) {
+		return nil, errors.New("read-only file system")
+	}}
+	if _, err := splitter.WriteSeq(products(1), nil); err == nil || !strings.Contains(err.Error(), "read-only") {
+		t.Errorf("expected a creation error, got %v", err)
+	}
+}
+
+// TestSeqIgnoringYield tests that URLs yielded after a failure are ignored and the first error is kept
+func TestSeqIgnoringYield(t *testing.T) {
+	seq := func(yield func(URL) bool) {
+		yield(URL{Loc: "https://www.example.com/" + strings.Repeat("a", 300)})
+		yield(URL{Loc: "https://www.example.com/2"})
+	}
+
+	calls := 0
+	splitter := &Splitter{
+		MaxBytes: 300,
+		Create:   memFiles{}.create,
+		Progress: func(Stats) { calls++ },
+	}
+	seqErr := func() error { return errors.New("unexpected call") }
+	if _, err := splitter.WriteSeq(seq, seqErr); err == nil || !strings.Contains(err.Error(), "does not fit") {
+		t.Errorf("expected the first error, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("expected no URL written after the failure, got %d", calls)
+	}
+}
@@ -1,13 +1,13 @@
 package twittercard
 
 import (
-	"context"
+	"fmt"
 	"html/template"
 	"io"
-	"log"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/internal/validate"
 )
 
 // TwitterCardType represents the type of Twitter Card.
@@ -73,6 +73,8 @@ type TwitterCard struct {
 	Creator     string          // Twitter username of the content creator
 	AppID       string          // App ID (used in app cards)
 	PlayerURL   string          // URL of the player (used in player cards)
+
+	mergedTitle teseo.MergedTitle
 }
 
 // NewCard initializes a TwitterCard based on the provided type.
@@ -271,85 +273,108 @@ func NewPlayerCard(title string, description string, image string, site string,
 
 // ToMetaTags generates the HTML meta tags for the Twitter Card using templ.Component
 func (tc *TwitterCard) ToMetaTags() templ.Component {
-	tc.ensureDefaults()
-	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
-		// Write each meta tag using the writeMetaTag helper
-		for _, tag := range tc.metaTags() {
-			if tag.content != "" {
-				if err := teseo.WriteMetaTag(w, tag.name, tag.content); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	})
+	return teseo.MetaTags(tc)
 }
 
 // ToGoHTMLMetaTags generates the HTML meta tags for the Twitter Card as `template.HTML` value for Go's html/template
 func (tc *TwitterCard) ToGoHTMLMetaTags() (template.HTML, error) {
-	// Create the templ component.
-	templComponent := tc.ToMetaTags()
+	return teseo.RenderGoHTML("twittercard.TwitterCard", tc.ToMetaTags())
+}
 
-	// Render the templ component to a `template.HTML` value.
-	html, err := templ.ToGoHTML(context.Background(), templComponent)
-	if err != nil {
-		log.Fatalf("failed to convert to html: %v", err)
-	}
+// Render writes the HTML meta tags for the Twitter Card to w, without going through templ.
+func (tc *TwitterCard) Render(w io.Writer) error {
+	return teseo.WriteMetaTags(w, tc)
+}
 
-	return html, nil
+// MergeSite fills the empty twitter:site and twitter:image with the Site defaults and formats
+// twitter:title with the title template of the Site.
+func (tc *TwitterCard) MergeSite(site *teseo.Site) {
+	if site == nil {
+		return
+	}
+	if tc.Title != "" {
+		site.MergeTitle(&tc.Title, &tc.mergedTitle)
+	}
+	if tc.Site == "" {
+		tc.Site = site.TwitterSite
+	}
+	if tc.Image == "" {
+		tc.Image = site.Image
+	}
 }
 
-// metaTags returns the meta tags for the Twitter Card as a slice of name-content pairs
-func (tc *TwitterCard) metaTags() []struct {
-	name    string
-	content string
-} {
-	metaTags := []struct {
-		name    string
-		content string
-	}{
-		{"twitter:card", tc.Card.String()},
-		{"twitter:title", tc.Title},
-		{"twitter:description", tc.Description},
+// Validate checks the TwitterCard against the Twitter/X Cards markup reference.
+// For more details see: https://developer.x.com/en/docs/x-for-websites/cards/overview/markup
+func (tc *TwitterCard) Validate() []teseo.Issue {
+	v := validate.New("twittercard.TwitterCard")
+	card := tc.cardType()
+	switch card {
+	case CardSummary, CardSummaryLargeImage, CardApp, CardPlayer:
+	default:
+		v.Add(teseo.SeverityError, "twitter:card", "enum", fmt.Sprintf("unknown card type %q", card))
 	}
 
-	if tc.Image != "" {
-		metaTags = append(metaTags, struct {
-			name    string
-			content string
-		}{"twitter:image", tc.Image})
+	v.Required("twitter:title", validate.NotEmpty(tc.Title))
+	v.Recommended("twitter:description", validate.NotEmpty(tc.Description))
+	v.Recommended("twitter:site", tc.Site != "")
+	if card == CardSummaryLargeImage || card == CardPlayer {
+		v.Required("twitter:image", tc.Image != "")
+	} else {
+		v.Recommended("twitter:image", tc.Image != "")
 	}
-	if tc.Site != "" {
-		metaTags = append(metaTags, struct {
-			name    string
-			content string
-		}{"twitter:site", tc.Site})
+	v.URL("twitter:image", tc.Image)
+
+	if card == CardApp {
+		v.Required("twitter:app:id:iphone", tc.AppID != "")
 	}
-	if tc.Creator != "" && (tc.Card == CardSummary || tc.Card == CardSummaryLargeImage) {
-		metaTags = append(metaTags, struct {
-			name    string
-			content string
-		}{"twitter:creator", tc.Creator})
+
+	if card == CardPlayer {
+		v.Required("twitter:player", tc.PlayerURL != "")
+		v.URL("twitter:player", tc.PlayerURL)
 	}
-	if tc.AppID != "" && tc.Card == CardApp {
-		metaTags = append(metaTags, struct {
-			name    string
-			content string
-		}{"twitter:app:id:iphone", tc.AppID})
+
+	return v.Issues()
+}
+
+// Tags returns the meta tags for the Twitter Card. Twitter Cards use the `name` attribute.
+func (tc *TwitterCard) Tags() []teseo.MetaTag {
+	card := tc.cardType()
+	tags := []teseo.MetaTag{
+		name("twitter:card", card.String()),
+		name("twitter:title", tc.Title),
+		name("twitter:description", tc.Description),
+		name("twitter:image", tc.Image),
+		name("twitter:site", tc.Site),
+	}
+
+	if card == CardSummary || card == CardSummaryLargeImage {
+		tags = append(tags, name("twitter:creator", tc.Creator))
 	}
-	if tc.PlayerURL != "" && tc.Card == CardPlayer {
-		metaTags = append(metaTags, struct {
-			name    string
-			content string
-		}{"twitter:player", tc.PlayerURL})
+	if card == CardApp {
+		tags = append(tags, name("twitter:app:id:iphone", tc.AppID))
 	}
+	if card == CardPlayer {
+		tags = append(tags, name("twitter:player", tc.PlayerURL))
+	}
+
+	result := tags[:0]
+	for _, tag := range tags {
+		if tag.Content != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
 
-	return metaTags
+// name returns a Twitter Card meta tag, written with the `name` attribute.
+func name(key, content string) teseo.MetaTag {
+	return teseo.MetaTag{Attribute: teseo.MetaName, Key: key, Content: content}
 }
 
-func (tc *TwitterCard) ensureDefaults() {
-	// Set default card type if not specified
+// cardType returns the card type, defaulting to CardSummary when not specified.
+func (tc *TwitterCard) cardType() TwitterCardType {
 	if tc.Card == "" {
-		tc.Card = CardSummary
+		return CardSummary
 	}
+	return tc.Card
 }
@@ -0,0 +1,61 @@
+package twittercard
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// TestConcurrentToMetaTags renders a shared card from many goroutines, run it with -race.
+// Rendering must not modify the card.
+func TestConcurrentToMetaTags(t *testing.T) {
+	card := &TwitterCard{Title: "Example", Creator: "@example"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			html, err := templ.ToGoHTML(context.Background(), card.ToMetaTags())
+			if err != nil {
+				t.Errorf("ToMetaTags failed: %v", err)
+				return
+			}
+			if !strings.Contains(string(html), `content="summary"`) {
+				t.Errorf("expected the default summary card, got %s", html)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if card.Card != "" {
+		t.Errorf("rendering modified the card type: %q", card.Card)
+	}
+}
+
+// TestValidate tests the card specific rules
+func TestValidate(t *testing.T) {
+	card := NewSummaryLargeImageCard("Example", "Example description", "", "@example", "@example")
+
+	issues := card.Validate()
+	if len(issues) != 1 || issues[0].Rule != "twittercard.TwitterCard.twitter:image.required" {
+		t.Errorf("expected a single error for the missing image, got %v", issues)
+	}
+}
+
+// TestMetaTagsUseName tests that the card properties are written with the name attribute
+func TestMetaTagsUseName(t *testing.T) {
+	html, err := NewSummaryCard("Example", "Example description", "", "@example", "").ToGoHTMLMetaTags()
+	if err != nil {
+		t.Fatalf("ToGoHTMLMetaTags failed: %v", err)
+	}
+	if strings.Contains(string(html), "property=") {
+		t.Errorf("expected no property attribute, got %s", html)
+	}
+	if !strings.Contains(string(html), `<meta name="twitter:card" content="summary" />`) {
+		t.Errorf("expected twitter:card with the name attribute, got %s", html)
+	}
+}
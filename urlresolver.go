@@ -0,0 +1,195 @@
+package teseo
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// TrailingSlash is the trailing slash normalisation rule of a URLResolver.
+type TrailingSlash int
+
+const (
+	// TrailingSlashKeep keeps the path as requested (default).
+	TrailingSlashKeep TrailingSlash = iota
+	// TrailingSlashAdd appends a trailing slash to every path, e.g. "/about/".
+	TrailingSlashAdd
+	// TrailingSlashRemove removes the trailing slash from every path but the root, e.g. "/about".
+	TrailingSlashRemove
+)
+
+// URLResolver builds the canonical URL of a request, e.g. for `og:url`, breadcrumbs or `<link rel="canonical">`.
+//
+// The scheme and host come from BaseURL when set. Otherwise they come from the `Forwarded` header,
+// or the `X-Forwarded-Proto` and `X-Forwarded-Host` headers, when the request is sent by a trusted
+// proxy, and from the request itself as a last resort. The forwarded values are read from right to
+// left, so values sent by the client in front of the ones appended by the proxies are ignored.
+// The query string is dropped, except for the parameters listed in KeepQuery.
+//
+// Example usage:
+//
+//	var resolver = &teseo.URLResolver{
+//		TrustedProxies: []string{"10.0.0.0/8"},
+//		KeepQuery:      []string{"page"},
+//		TrailingSlash:  teseo.TrailingSlashRemove,
+//		Lowercase:      true,
+//	}
+//
+//	// In the handler
+//	pageURL := resolver.Resolve(r) // e.g. "https://www.example.com/blog?page=2"
+type URLResolver struct {
+	BaseURL        string        // Public base URL, e.g. "https://www.example.com"; its path is used as prefix
+	TrustedProxies []string      // IP addresses or CIDR ranges of the proxies whose forwarded headers are honoured
+	KeepQuery      []string      // Query parameters kept in the URL, e.g. "page"; all others are dropped
+	TrailingSlash  TrailingSlash // Trailing slash normalisation rule
+	Lowercase      bool          // Lowercase the path; the host is always lowercased
+}
+
+// Resolve returns the canonical URL of the request.
+func (u *URLResolver) Resolve(r *http.Request) string {
+	scheme, host, prefix := u.origin(r)
+
+	path := r.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	path = strings.TrimSuffix(prefix, "/") + path
+	if u.Lowercase {
+		path = strings.ToLower(path)
+	}
+	switch u.TrailingSlash {
+	case TrailingSlashAdd:
+		if !strings.HasSuffix(path, "/") {
+			path += "/"
+		}
+	case TrailingSlashRemove:
+		if len(path) > 1 {
+			path = strings.TrimRight(path, "/")
+		}
+	}
+
+	result := scheme + "://" + strings.ToLower(host) + path
+	if query := u.query(r.URL.Query()); query != "" {
+		result += "?" + query
+	}
+	return result
+}
+
+// origin returns the scheme, the host and the path prefix of the public URL.
+func (u *URLResolver) origin(r *http.Request) (scheme, host, prefix string) {
+	if u.BaseURL != "" {
+		if base, err := url.Parse(u.BaseURL); err == nil && base.Host != "" {
+			return base.Scheme, base.Host, base.EscapedPath()
+		}
+	}
+
+	scheme = "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	host = r.Host
+
+	if u.trusted(r.RemoteAddr) {
+		proto, forwardedHost := u.forwarded(r.Header)
+		if proto == "" {
+			proto = strings.ToLower(lastValue(r.Header, "X-Forwarded-Proto"))
+		}
+		if forwardedHost == "" {
+			forwardedHost = lastValue(r.Header, "X-Forwarded-Host")
+		}
+		if proto == "http" || proto == "https" {
+			scheme = proto
+		}
+		if forwardedHost != "" {
+			host = forwardedHost
+		}
+	}
+
+	return scheme, host, ""
+}
+
+// trusted reports whether remoteAddr belongs to one of the TrustedProxies.
+func (u *URLResolver) trusted(remoteAddr string) bool {
+	if len(u.TrustedProxies) == 0 {
+		return false
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = strings.Trim(remoteAddr, "[]")
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, proxy := range u.TrustedProxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			if prefix.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if proxyAddr, err := netip.ParseAddr(proxy); err == nil && proxyAddr.Unmap() == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// query returns the encoded KeepQuery parameters of values, sorted by name.
+func (u *URLResolver) query(values url.Values) string {
+	kept := url.Values{}
+	for _, name := range u.KeepQuery {
+		if v, ok := values[name]; ok {
+			kept[name] = v
+		}
+	}
+	return kept.Encode()
+}
+
+// forwarded returns the proto and host of the `Forwarded` header (RFC 7239) element set by the proxy
+// receiving the client request. Each proxy appends an element whose `for` is the address it received
+// the request from: the elements are walked from right to left while `for` is a trusted proxy, the
+// elements on the left of the first untrusted hop are set by the client and ignored.
+func (u *URLResolver) forwarded(header http.Header) (proto, host string) {
+	elements := headerValues(header, "Forwarded")
+	for i := len(elements) - 1; i >= 0; i-- {
+		params := map[string]string{}
+		for _, pair := range strings.Split(elements[i], ";") {
+			if name, v, ok := strings.Cut(strings.TrimSpace(pair), "="); ok {
+				params[strings.ToLower(name)] = strings.Trim(v, `"`)
+			}
+		}
+		if i > 0 && u.trusted(params["for"]) {
+			continue
+		}
+		return strings.ToLower(params["proto"]), params["host"]
+	}
+	return "", ""
+}
+
+// headerValues returns the elements of the comma separated values of the header name, in order.
+func headerValues(header http.Header, name string) []string {
+	var elements []string
+	for _, value := range header.Values(name) {
+		for _, element := range strings.Split(value, ",") {
+			if element = strings.TrimSpace(element); element != "" {
+				elements = append(elements, element)
+			}
+		}
+	}
+	return elements
+}
+
+// lastValue returns the last element of the header name, i.e. the one appended by the proxy
+// connected to the server; the elements on its left may be set by the client.
+func lastValue(header http.Header, name string) string {
+	elements := headerValues(header, name)
+	if len(elements) == 0 {
+		return ""
+	}
+	return elements[len(elements)-1]
+}
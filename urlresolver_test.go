@@ -0,0 +1,59 @@
+package teseo
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// TestURLResolver tests the proxy headers, the query allow-list and the normalisation rules
+func TestURLResolver(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolver URLResolver
+		target   string
+		remote   string
+		headers  map[string]string
+		expected string
+	}{
+		{"request", URLResolver{}, "http://internal:8080/Blog/?page=2&utm_source=x", "10.0.0.1:1234", nil,
+			"http://internal:8080/Blog/"},
+		{"untrusted proxy", URLResolver{TrustedProxies: []string{"10.0.0.0/8"}}, "http://internal/blog", "192.0.2.1:1234",
+			map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "evil.example.com"}, "http://internal/blog"},
+		{"x-forwarded", URLResolver{TrustedProxies: []string{"10.0.0.0/8"}}, "http://internal/blog", "10.1.2.3:1234",
+			map[string]string{"X-Forwarded-Proto": "HTTPS", "X-Forwarded-Host": "WWW.Example.com"}, "https://www.example.com/blog"},
+		{"forged x-forwarded", URLResolver{TrustedProxies: []string{"10.0.0.0/8"}}, "http://internal/blog", "10.0.0.1:1234",
+			map[string]string{"X-Forwarded-Proto": "http, https", "X-Forwarded-Host": "evil.example.com, www.example.com"}, "https://www.example.com/blog"},
+		{"forwarded", URLResolver{TrustedProxies: []string{"10.0.0.1", "10.0.0.2"}}, "http://internal/blog", "10.0.0.1:1234",
+			map[string]string{"Forwarded": `for=192.0.2.60;proto=https;host="www.example.com", for=10.0.0.2`, "X-Forwarded-Host": "other.example.com"},
+			"https://www.example.com/blog"},
+		{"forged forwarded", URLResolver{TrustedProxies: []string{"10.0.0.0/8"}}, "http://internal/blog", "10.0.0.1:1234",
+			map[string]string{"Forwarded": `for=10.0.0.9;host=evil.example.com, for=192.0.2.60;proto=https;host=www.example.com, for="[2001:db8::1]"`},
+			"http://internal/blog"},
+		{"forwarded ipv6 proxy", URLResolver{TrustedProxies: []string{"10.0.0.0/8", "2001:db8::/32"}}, "http://internal/blog", "10.0.0.1:1234",
+			map[string]string{"Forwarded": `for=10.0.0.9;host=evil.example.com, for=192.0.2.60;proto=https;host=www.example.com, for="[2001:db8::1]:4711"`},
+			"https://www.example.com/blog"},
+		{"base url", URLResolver{BaseURL: "https://www.example.com/app/"}, "http://internal/blog", "10.0.0.1:1234",
+			map[string]string{"X-Forwarded-Host": "other.example.com"}, "https://www.example.com/app/blog"},
+		{"keep query", URLResolver{KeepQuery: []string{"q", "page"}}, "http://www.example.com/search?utm_source=x&page=2&q=go", "", nil,
+			"http://www.example.com/search?page=2&q=go"},
+		{"add slash", URLResolver{TrailingSlash: TrailingSlashAdd}, "http://www.example.com/about", "", nil, "http://www.example.com/about/"},
+		{"remove slash", URLResolver{TrailingSlash: TrailingSlashRemove, Lowercase: true}, "http://www.example.com/About/", "", nil,
+			"http://www.example.com/about"},
+		{"root", URLResolver{TrailingSlash: TrailingSlashRemove}, "http://www.example.com/", "", nil, "http://www.example.com/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tt.target, nil)
+			if tt.remote != "" {
+				r.RemoteAddr = tt.remote
+			}
+			for name, value := range tt.headers {
+				r.Header.Set(name, value)
+			}
+			if got := tt.resolver.Resolve(r); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
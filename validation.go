@@ -0,0 +1,47 @@
+package teseo
+
+import "fmt"
+
+// Severity represents the severity of a validation Issue.
+type Severity string
+
+func (s Severity) String() string {
+	return string(s)
+}
+
+const (
+	// SeverityError marks a missing required property or a malformed value. The entity is not
+	// eligible for rich results until it is fixed.
+	SeverityError Severity = "error"
+	// SeverityWarning marks a missing recommended property.
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a single problem reported by the Validate method of an entity.
+type Issue struct {
+	Severity Severity // SeverityError or SeverityWarning
+	Rule     string   // Machine-readable rule id, e.g. "schemaorg.Product.offers.price.required"
+	Field    string   // Path of the property, e.g. "review[0].author"
+	Message  string   // Human-readable description of the problem
+}
+
+// String returns the issue formatted as "severity: message (rule)".
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s (%s)", i.Severity, i.Message, i.Rule)
+}
+
+// Validator is the interface implemented by every entity checking its properties against the
+// Google rich results guidelines and the Open Graph and Twitter Cards specifications.
+type Validator interface {
+	Validate() []Issue
+}
+
+// HasErrors reports whether issues contains at least one issue with SeverityError.
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}